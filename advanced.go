@@ -0,0 +1,62 @@
+package main
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+)
+
+func init() {
+	RegisterChapter(Chapter{9, "Advanced", []Lesson{
+		{"reflection", "inspecting and setting values", reflectionLesson, []string{"reflect", "TypeOf", "ValueOf", "struct tag", "NumField", "CanSet", "MethodByName", "Call"}, []string{"interfaces", "structures"}, []string{"advanced", "reflection"}},
+		{"logging", "structured logging with slog", loggingLesson, []string{"log/slog", "slog", "structured logging", "json"}, []string{"structures"}, []string{"intermediate", "logging"}},
+		{"unsafe", "sizes, alignment and unsafe.Pointer", unsafeLesson, []string{"unsafe", "Sizeof", "Alignof", "Offsetof", "unsafe.Pointer", "padding"}, []string{"structures", "bits"}, []string{"advanced", "unsafe"}},
+		{"uintptr", "uintptr and pointer arithmetic pitfalls", uintptrLesson, []string{"uintptr", "unsafe.Pointer", "unsafe.Add", "pointer arithmetic", "garbage collector", "checkptr", "go vet"}, []string{"unsafe"}, []string{"advanced", "unsafe"}},
+		{"cgo", "calling C code", cgoLesson, []string{"cgo", "C", "unsafe"}, []string{"functions"}, []string{"advanced", "cgo"}},
+	}})
+}
+
+// structured logging records key/value attributes
+// that tools can filter and parse
+// where fmt.Printf only produces free form text
+func logOrder(logger *slog.Logger, orderId int, total float64) {
+	orderLogger := logger.With("orderId", orderId)
+	orderLogger.Info("order placed", "total", total)
+}
+
+func loggingLesson() {
+
+	// structured logging
+	// with a json or a text handler
+	trace()
+	jsonLogger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
+	jsonLogger.Info("cookie baked", "flavour", "Chocolate", "size", 10)
+
+	textLogger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	textLogger.Info("cookie baked", "flavour", "Chocolate", "size", 10)
+
+	// sub loggers carry their attributes
+	// into every record they log
+	trace()
+	logOrder(jsonLogger, 1, 12.5)
+
+	// the default level is info
+	// so debug records are suppressed
+	trace()
+	textLogger.Debug("will not be logged")
+	textLogger.Warn("running low on chocolate")
+	textLogger.Error("out of chocolate")
+
+	debugLogger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	debugLogger.Debug("logged at the debug level")
+}
+
+func cgoLesson() {
+
+	// calling C code
+	trace()
+	Print("Hello")
+	fmt.Println()
+	// Output:
+	// Hello
+}
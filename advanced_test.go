@@ -0,0 +1,29 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"testing"
+)
+
+func TestLogOrderWritesJSON(t *testing.T) {
+	var buffer bytes.Buffer
+	logOrder(slog.New(slog.NewJSONHandler(&buffer, nil)), 1, 12.5)
+
+	var record map[string]interface{}
+	if err := json.Unmarshal(buffer.Bytes(), &record); err != nil {
+		t.Fatalf("invalid json %q: %v", buffer.String(), err)
+	}
+	want := map[string]interface{}{
+		"level":   "INFO",
+		"msg":     "order placed",
+		"orderId": 1.0,
+		"total":   12.5,
+	}
+	for key, value := range want {
+		if record[key] != value {
+			t.Errorf("record[%q] = %v, want %v", key, record[key], value)
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+// a counter incremented by many goroutines
+// without a lock
+// the atomic types cannot be copied by accident
+// go vet reports it like for a mutex
+type hitCounter struct {
+	hits atomic.Int64
+}
+
+func (c *hitCounter) hit() {
+	c.hits.Add(1)
+}
+
+// CompareAndSwap writes only when the value
+// is still the one that was loaded
+// losing the race means loading again and retrying
+func (c *hitCounter) hitUpTo(limit int64) bool {
+	for {
+		current := c.hits.Load()
+		if current >= limit {
+			return false
+		}
+		if c.hits.CompareAndSwap(current, current+1) {
+			return true
+		}
+	}
+}
+
+func (c *hitCounter) count() int64 {
+	return c.hits.Load()
+}
+
+// the same counter guarded by a mutex
+// go test -bench Increment compares them
+// atomics protect a single value
+// several values changing together still need a mutex
+type lockedCounter struct {
+	mutex sync.Mutex
+	hits  int64
+}
+
+func (c *lockedCounter) hit() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.hits++
+}
+
+// a flag that can be claimed a single time
+// only the first swap from false to true succeeds
+type oneTimeFlag struct {
+	claimed atomic.Bool
+}
+
+func (f *oneTimeFlag) claim() bool {
+	return f.claimed.CompareAndSwap(false, true)
+}
+
+// runs f in n goroutines and waits for them
+func runTimes(n int, f func()) {
+	functions := make([]func(), n)
+	for i := range functions {
+		functions[i] = f
+	}
+	runConcurrently(functions...)
+}
+
+func atomicsLesson() {
+
+	// atomic increments never lose an update
+	trace()
+	var counter hitCounter
+	runTimes(100, counter.hit)
+	fmt.Printf("hits: %v\n", counter.count())
+	// Output:
+	// hits: 100
+
+	// a lock free counter that stops at a limit
+	trace()
+	var limited hitCounter
+	var accepted atomic.Int64
+	runTimes(50, func() {
+		if limited.hitUpTo(10) {
+			accepted.Add(1)
+		}
+	})
+	fmt.Printf("hits: %v, accepted: %v\n", limited.count(), accepted.Load())
+	// Output:
+	// hits: 10, accepted: 10
+
+	// a one time flag
+	// every goroutine tries to claim it
+	trace()
+	var flag oneTimeFlag
+	var winners atomic.Int64
+	runTimes(5, func() {
+		if flag.claim() {
+			winners.Add(1)
+		}
+	})
+	fmt.Printf("winners: %v, claimed: %v\n", winners.Load(), flag.claimed.Load())
+	// Output:
+	// winners: 1, claimed: true
+}
@@ -0,0 +1,48 @@
+package main
+
+import "testing"
+
+func TestHitCounterStopsAtLimit(t *testing.T) {
+	var counter hitCounter
+	accepted := 0
+	for range 5 {
+		if counter.hitUpTo(3) {
+			accepted++
+		}
+	}
+	if accepted != 3 || counter.count() != 3 {
+		t.Errorf("hitUpTo(3) accepted %v hits and counted %v, want 3 and 3", accepted, counter.count())
+	}
+}
+
+func TestOneTimeFlagClaimsOnce(t *testing.T) {
+	var flag oneTimeFlag
+	if !flag.claim() {
+		t.Error("first claim() = false, want true")
+	}
+	if flag.claim() {
+		t.Error("second claim() = true, want false")
+	}
+}
+
+// comparing increments from parallel goroutines
+// the atomic Add is a single instruction
+// where the mutex locks and unlocks around it
+// and falls further behind under contention
+func BenchmarkAtomicIncrement(b *testing.B) {
+	var counter hitCounter
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			counter.hit()
+		}
+	})
+}
+
+func BenchmarkMutexIncrement(b *testing.B) {
+	var counter lockedCounter
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			counter.hit()
+		}
+	})
+}
@@ -0,0 +1,506 @@
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+func init() {
+	RegisterChapter(Chapter{1, "Basics", []Lesson{
+		{"variables", "declaring variables", variablesLesson, []string{"var", ":=", "declaration"}, nil, []string{"beginner", "basics"}},
+		{"shadowing", "variable shadowing and the err gotcha", shadowingLesson, []string{"shadowing", ":=", "scope", "err", "block"}, []string{"variables"}, []string{"beginner", "basics"}},
+		{"types", "named types, aliases and enums", typesLesson, []string{"type", "type alias", "alias", "conversion", "enum", "iota", "const", "String", "bit flags", "1 << iota"}, []string{"variables"}, []string{"beginner", "basics"}},
+		{"loops", "three clause loops and ranging over integers", loopsLesson, []string{"for", "range", "range over int", "loop", "off by one"}, []string{"variables"}, []string{"beginner", "basics"}},
+		{"labels", "labeled break, continue and goto", labelsLesson, []string{"label", "break", "continue", "goto", "nested loops", "select"}, []string{"loops"}, []string{"beginner", "basics"}},
+		{"switches", "switch, fallthrough and init statements", switchesLesson, []string{"switch", "case", "fallthrough", "break", "default"}, []string{"loops"}, []string{"beginner", "basics"}},
+		{"bits", "bitwise operators and math/bits", bitsLesson, []string{"bits", "&", "|", "^", "&^", "<<", ">>", "%b", "math/bits", "OnesCount", "LeadingZeros", "RotateLeft"}, []string{"types"}, []string{"intermediate", "basics"}},
+		{"integers", "integer ranges, overflow and conversions", integersLesson, []string{"int8", "uint8", "int64", "overflow", "wraparound", "conversion", "modulo", "division", "math.MaxInt"}, []string{"types"}, []string{"beginner", "basics"}},
+		{"floats", "floating point gotchas and the math package", floatsLesson, []string{"float64", "NaN", "Inf", "epsilon", "math", "math.Floor", "math.Ceil", "math.Mod", "math.Abs", "rounding"}, []string{"integers"}, []string{"beginner", "basics"}},
+		{"constants", "untyped constants and constant expressions", constantsLesson, []string{"const", "untyped constant", "default type", "constant expression", "overflow"}, []string{"integers", "floats"}, []string{"intermediate", "basics"}},
+		{"comparing", "the cmp package and the min, max and clear builtins", comparingLesson, []string{"cmp", "cmp.Compare", "cmp.Or", "min", "max", "clear"}, []string{"types"}, []string{"beginner", "basics"}},
+	}})
+}
+
+// the classic shadowing bug
+// := inside the loop declares a new err
+// the outer one is never assigned
+// and the failure is lost
+func firstFailureShadowed(inputs []string) error {
+	var err error
+	for _, input := range inputs {
+		_, err := strconv.Atoi(input)
+		if err != nil {
+			break
+		}
+	}
+	return err
+}
+
+// = assigns the outer err
+func firstFailure(inputs []string) error {
+	var err error
+	for _, input := range inputs {
+		_, err = strconv.Atoi(input)
+		if err != nil {
+			break
+		}
+	}
+	return err
+}
+
+func variablesLesson() {
+
+	// variable declarations
+	trace()
+	var number int = 1
+	var one, two = 1, 2
+	three := 3
+
+	// unused variables produce compilation errors
+	trace()
+	fmt.Println(number + one + two + three)
+	// Output:
+	// 7
+}
+
+func shadowingLesson() {
+
+	// := in an inner block declares a new variable
+	// hiding the outer one until the block ends
+	trace()
+	count := 1
+	if true {
+		count := 2
+		count++
+		fmt.Printf("inner count: %v\n", count)
+	}
+	fmt.Printf("outer count: %v\n", count)
+	// Output:
+	// inner count: 3
+	// outer count: 1
+
+	// = assigns the outer variable
+	trace()
+	if true {
+		count = 2
+	}
+	fmt.Printf("outer count: %v\n", count)
+	// Output:
+	// outer count: 2
+
+	// the same mistake with err
+	// silently drops failures
+	trace()
+	inputs := []string{"1", "two", "3"}
+	fmt.Printf("shadowed: %v\n", firstFailureShadowed(inputs))
+	fmt.Printf("fixed: %v\n", firstFailure(inputs))
+	// Output:
+	// shadowed: <nil>
+	// fixed: strconv.Atoi: parsing "two": invalid syntax
+}
+
+// something like an enum
+// iota counts the constants of the block
+// stringer generates the String method
+// that makes %v print the names
+// go generate ./...
+//
+//go:generate stringer -type=Flavor
+type Flavor int32
+
+const (
+	Vanilla Flavor = iota
+	Chocolate
+	Pistachios
+)
+
+// bit flags shift a single bit
+// one more position for each constant
+// so they can be combined with |
+type Topping uint8
+
+const (
+	Sprinkles Topping = 1 << iota
+	Caramel
+	Nuts
+)
+
+// _ skips the first value of iota
+// every constant repeats the last expression
+type ByteSize int64
+
+const (
+	_           = iota
+	KB ByteSize = 1 << (10 * iota)
+	MB
+	GB
+)
+
+// a named type is a new and distinct type
+// it can have its own methods
+type Celsius float64
+
+func (c Celsius) Fahrenheit() float64 {
+	return float64(c)*9/5 + 32
+}
+
+// an alias is only a second name for a type
+// it cannot have methods of its own
+// since float64 is not declared in this package
+type Degrees = float64
+
+// aliases exist for gradual refactoring
+// a type moved or renamed keeps its old name
+// as an alias until every caller is updated
+type Formula = Recipe
+
+func typesLesson() {
+
+	// named types
+	trace()
+	type ShoeSize int
+	var _ ShoeSize = ShoeSize(14)
+
+	// named types need explicit conversions
+	// aliases are interchangeable with their type
+	trace()
+	var reading float64 = 21.5
+	var named Celsius = Celsius(reading)
+	var aliased Degrees = reading
+	fmt.Printf("named: %T, aliased: %T\n", named, aliased)
+	fmt.Printf("%v°C is %v°F\n", named, named.Fahrenheit())
+	// Output:
+	// named: main.Celsius, aliased: float64
+	// 21.5°C is 70.7°F
+
+	// byte, rune and any are aliases too
+	trace()
+	var letter byte = 'a'
+	var number uint8 = letter
+	var values []interface{} = []any{number}
+	fmt.Printf("letter: %T, values: %T\n", letter, values)
+	// Output:
+	// letter: uint8, values: []interface {}
+
+	// the old name still works during a refactoring
+	trace()
+	var formula Formula = Recipe{Name: "Cookies"}
+	var recipe Recipe = formula
+	fmt.Printf("recipe: %v, type: %T\n", recipe.Name, formula)
+	// Output:
+	// recipe: Cookies, type: main.Recipe
+
+	// something like an enum
+	trace()
+	var bestFlavor Flavor = Chocolate
+	fmt.Printf("bestFlavor: %v, as a number: %d\n", bestFlavor, bestFlavor)
+	fmt.Printf("unknown: %v\n", Flavor(7))
+	// Output:
+	// bestFlavor: Chocolate, as a number: 1
+	// unknown: Flavor(7)
+
+	// combining and testing bit flags
+	trace()
+	toppings := Sprinkles | Nuts
+	fmt.Printf("toppings: %03b\n", toppings)
+	fmt.Printf("has nuts: %v, has caramel: %v\n", toppings&Nuts != 0, toppings&Caramel != 0)
+	// Output:
+	// toppings: 101
+	// has nuts: true, has caramel: false
+
+	// size constants
+	trace()
+	fmt.Printf("KB: %v, MB: %v, GB: %v\n", KB, MB, GB)
+	fmt.Printf("a 3 MB file holds %v KB\n", 3*MB/KB)
+	// Output:
+	// KB: 1024, MB: 1048576, GB: 1073741824
+	// a 3 MB file holds 3072 KB
+}
+
+func loopsLesson() {
+
+	// the classic three clause loop
+	trace()
+	for i := 0; i < 3; i++ {
+		fmt.Printf("classic: %v\n", i)
+	}
+	// Output:
+	// classic: 0
+	// classic: 1
+	// classic: 2
+
+	// ranging over an integer
+	// does the same with less to get wrong
+	// i goes from 0 to n-1
+	trace()
+	for i := range 3 {
+		fmt.Printf("range: %v\n", i)
+	}
+	// Output:
+	// range: 0
+	// range: 1
+	// range: 2
+
+	// n itself is never reached
+	// and ranging over 0 runs no iteration
+	trace()
+	last := -1
+	for i := range 3 {
+		last = i
+	}
+	fmt.Printf("last: %v\n", last)
+	for range 0 {
+		fmt.Println("never printed")
+	}
+	// Output:
+	// last: 2
+
+	// the variable can be left out
+	// to repeat something n times
+	trace()
+	for range 2 {
+		fmt.Println("again")
+	}
+	// Output:
+	// again
+	// again
+
+	// counting down or by steps
+	// still takes the three clause loop
+	trace()
+	for i := 4; i > 0; i -= 2 {
+		fmt.Printf("down: %v\n", i)
+	}
+	// Output:
+	// down: 4
+	// down: 2
+}
+
+func labelsLesson() {
+
+	// a plain break only leaves the inner loop
+	// a labeled break leaves the loop it names
+	trace()
+	grid := [][]int{{1, 2, 3}, {4, -5, 6}, {7, 8, 9}}
+search:
+	for row, values := range grid {
+		for column, value := range values {
+			if value < 0 {
+				fmt.Printf("negative at %v,%v\n", row, column)
+				break search
+			}
+		}
+	}
+	// Output:
+	// negative at 1,1
+
+	// a labeled continue moves on
+	// to the next iteration of the outer loop
+	trace()
+rows:
+	for row, values := range grid {
+		for _, value := range values {
+			if value < 0 {
+				continue rows
+			}
+		}
+		fmt.Printf("row %v is all positive\n", row)
+	}
+	// Output:
+	// row 0 is all positive
+	// row 2 is all positive
+
+	// inside a select a plain break
+	// only leaves the select
+	// the label is needed to leave the loop
+	trace()
+	events := make(chan string, 3)
+	events <- "click"
+	events <- "quit"
+	events <- "click"
+events:
+	for {
+		select {
+		case event := <-events:
+			if event == "quit" {
+				break events
+			}
+			fmt.Printf("handling %v\n", event)
+		}
+	}
+	fmt.Printf("%v events left\n", len(events))
+	// Output:
+	// handling click
+	// 1 events left
+
+	// goto jumps to a label in the same function
+	// it cannot jump over variable declarations
+	// or into a block
+	// rarely seen outside generated code
+	trace()
+	attempts := 0
+retry:
+	attempts++
+	if attempts < 3 {
+		goto retry
+	}
+	fmt.Printf("attempts: %v\n", attempts)
+	// Output:
+	// attempts: 3
+}
+
+// the season of a month
+// cases list several values
+// and stop at the first match
+func season(month time.Month) string {
+	switch month {
+	case time.December, time.January, time.February:
+		return "winter"
+	case time.March, time.April, time.May:
+		return "spring"
+	case time.June, time.July, time.August:
+		return "summer"
+	default:
+		return "autumn"
+	}
+}
+
+func switchesLesson() {
+
+	// cases do not fall through
+	// there is no break to write
+	trace()
+	for _, month := range []time.Month{time.January, time.July, time.October} {
+		fmt.Printf("%v is in %v\n", month, season(month))
+	}
+	// Output:
+	// January is in winter
+	// July is in summer
+	// October is in autumn
+
+	// a switch without an expression
+	// tests each case as a condition
+	// a cleaner chain of if else
+	trace()
+	for _, temperature := range []int{-5, 12, 30} {
+		switch {
+		case temperature < 0:
+			fmt.Printf("%v is freezing\n", temperature)
+		case temperature < 20:
+			fmt.Printf("%v is mild\n", temperature)
+		default:
+			fmt.Printf("%v is hot\n", temperature)
+		}
+	}
+	// Output:
+	// -5 is freezing
+	// 12 is mild
+	// 30 is hot
+
+	// fallthrough runs the next case
+	// without testing its condition
+	// it must be the last statement of a case
+	trace()
+	switch level := 2; level {
+	case 3:
+		fmt.Println("granted admin access")
+		fallthrough
+	case 2:
+		fmt.Println("granted write access")
+		fallthrough
+	case 1:
+		fmt.Println("granted read access")
+	}
+	// Output:
+	// granted write access
+	// granted read access
+
+	// an init statement scopes a variable
+	// to the switch like it does for if
+	trace()
+	switch length := len("gopher"); {
+	case length > 5:
+		fmt.Printf("long word of %v letters\n", length)
+	default:
+		fmt.Printf("short word of %v letters\n", length)
+	}
+	// Output:
+	// long word of 6 letters
+
+	// a break inside a switch
+	// leaves the switch not the loop
+	trace()
+	for i := range 3 {
+		switch i {
+		case 1:
+			fmt.Println("breaking at 1")
+			break
+		}
+		fmt.Printf("still looping at %v\n", i)
+	}
+	// Output:
+	// still looping at 0
+	// breaking at 1
+	// still looping at 1
+	// still looping at 2
+}
+
+func comparingLesson() {
+
+	// cmp.Compare returns -1, 0 or +1
+	// the shape sorting functions expect
+	trace()
+	fmt.Println(cmp.Compare(1, 2), cmp.Compare("b", "b"), cmp.Compare(2.5, 1.0))
+	// Output:
+	// -1 0 1
+
+	// cmp.Or returns its first non zero argument
+	// handy for default values
+	trace()
+	configuredPort := ""
+	fmt.Printf("port: %v\n", cmp.Or(configuredPort, "8080"))
+	// Output:
+	// port: 8080
+
+	// together they sort on several fields
+	// the first non zero comparison wins
+	trace()
+	type Person struct {
+		Name string
+		Age  int
+	}
+	people := []Person{{"Bob", 30}, {"Alice", 30}, {"Carl", 25}}
+	slices.SortFunc(people, func(a, b Person) int {
+		return cmp.Or(cmp.Compare(a.Age, b.Age), strings.Compare(a.Name, b.Name))
+	})
+	fmt.Printf("people: %v\n", people)
+	// Output:
+	// people: [{Carl 25} {Alice 30} {Bob 30}]
+
+	// min and max are builtins
+	// taking any number of ordered arguments
+	// no need for the generic Min and Max
+	// of the functions lesson anymore
+	trace()
+	fmt.Printf("min: %v, max: %v\n", min(3, 1, 2), max(2.5, 1))
+	fmt.Printf("min string: %v\n", min("banana", "apple"))
+	// Output:
+	// min: 1, max: 2.5
+	// min string: apple
+
+	// clear empties a map
+	// and zeroes the elements of a slice
+	// keeping its length
+	trace()
+	scores := map[string]int{"alice": 1, "bob": 2}
+	clear(scores)
+	numbers := []int{1, 2, 3}
+	clear(numbers)
+	fmt.Printf("scores: %v, numbers: %v\n", scores, numbers)
+	// Output:
+	// scores: map[], numbers: [0 0 0]
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func TestFlavorString(t *testing.T) {
+	tests := []struct {
+		flavor Flavor
+		want   string
+	}{
+		{Vanilla, "Vanilla"},
+		{Pistachios, "Pistachios"},
+		{Flavor(-1), "Flavor(-1)"},
+		{Flavor(3), "Flavor(3)"},
+	}
+	for _, test := range tests {
+		if got := test.flavor.String(); got != test.want {
+			t.Errorf("Flavor(%d).String() = %v, want %v", int32(test.flavor), got, test.want)
+		}
+	}
+}
+
+func TestFirstFailureShadowing(t *testing.T) {
+	inputs := []string{"1", "two", "3"}
+	if err := firstFailureShadowed(inputs); err != nil {
+		t.Errorf("firstFailureShadowed(%v) = %v, want the shadowed nil", inputs, err)
+	}
+	if err := firstFailure(inputs); !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("firstFailure(%v) = %v, want a syntax error", inputs, err)
+	}
+	if err := firstFailure([]string{"1"}); err != nil {
+		t.Errorf("firstFailure([1]) = %v, want nil", err)
+	}
+}
+
+func TestCelsiusFahrenheit(t *testing.T) {
+	tests := []struct {
+		celsius Celsius
+		want    float64
+	}{
+		{0, 32},
+		{100, 212},
+		{-40, -40},
+	}
+	for _, test := range tests {
+		if got := test.celsius.Fahrenheit(); got != test.want {
+			t.Errorf("Celsius(%v).Fahrenheit() = %v, want %v", float64(test.celsius), got, test.want)
+		}
+	}
+}
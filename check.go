@@ -0,0 +1,82 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// checking that the output comments
+// still match what the lessons print
+// go run . -check
+// lessons without output comments are skipped
+
+// the output comments of a lesson in order
+// returns false when there are none
+func expectedOutput(source string) (string, bool) {
+	var expected strings.Builder
+	found := false
+	inOutput := false
+	for _, line := range strings.Split(source, "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case line == "// Output:":
+			found = true
+			inOutput = true
+		case inOutput && strings.HasPrefix(line, "//"):
+			expected.WriteString(strings.TrimPrefix(strings.TrimPrefix(line, "//"), " ") + "\n")
+		default:
+			inOutput = false
+		}
+	}
+	return expected.String(), found
+}
+
+// output comments cannot hold trailing spaces
+// so they are ignored in what was printed
+func trimLines(output string) string {
+	lines := strings.Split(output, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t")
+	}
+	return strings.Join(lines, "\n")
+}
+
+// the differing lines
+// prefixed with - for the expected ones
+// and + for the printed ones
+func diffLines(want string, got string) string {
+	wantLines := strings.Split(strings.TrimSuffix(want, "\n"), "\n")
+	gotLines := strings.Split(strings.TrimSuffix(got, "\n"), "\n")
+
+	var diff strings.Builder
+	for i := 0; i < max(len(wantLines), len(gotLines)); i++ {
+		switch {
+		case i >= len(gotLines):
+			fmt.Fprintf(&diff, "%4v - %v\n", i+1, wantLines[i])
+		case i >= len(wantLines):
+			fmt.Fprintf(&diff, "%4v + %v\n", i+1, gotLines[i])
+		case wantLines[i] != gotLines[i]:
+			fmt.Fprintf(&diff, "%4v - %v\n", i+1, wantLines[i])
+			fmt.Fprintf(&diff, "%4v + %v\n", i+1, gotLines[i])
+		}
+	}
+	return diff.String()
+}
+
+// returns the differences
+// and whether the lesson had output comments
+func checkLesson(lesson Lesson) (string, bool, error) {
+	code, err := lessonSource(lesson)
+	if err != nil {
+		return "", false, err
+	}
+	want, ok := expectedOutput(code.Source)
+	if !ok {
+		return "", false, nil
+	}
+	got, err := captureOutput(lesson.Run)
+	if err != nil {
+		return "", true, err
+	}
+	return diffLines(want, trimLines(got)), true, nil
+}
@@ -0,0 +1,64 @@
+package main
+
+import "testing"
+
+func TestExpectedOutput(t *testing.T) {
+	source := `func lesson() {
+
+	// printing
+	fmt.Println("a")
+	// Output:
+	// a
+	//
+	// b
+
+	// not an output
+	fmt.Println("c")
+	// Output:
+	// c
+}`
+	want := "a\n\nb\nc\n"
+	if got, ok := expectedOutput(source); !ok || got != want {
+		t.Errorf("expectedOutput() = %q, %v, want %q, true", got, ok, want)
+	}
+	if _, ok := expectedOutput("func lesson() {\n}"); ok {
+		t.Error("expectedOutput() found output comments in a lesson without any")
+	}
+}
+
+func TestDiffLines(t *testing.T) {
+	var tests = []struct {
+		want string
+		got  string
+		diff string
+	}{
+		{"a\nb\n", "a\nb\n", ""},
+		{"a\nb\n", "a\nc\n", "   2 - b\n   2 + c\n"},
+		{"a\n", "a\nb\n", "   2 + b\n"},
+		{"a\nb\n", "a\n", "   2 - b\n"},
+	}
+	for _, test := range tests {
+		if diff := diffLines(test.want, test.got); diff != test.diff {
+			t.Errorf("diffLines(%q, %q) = %q, want %q", test.want, test.got, diff, test.diff)
+		}
+	}
+}
+
+func TestCheckLessons(t *testing.T) {
+	deterministicReal := deterministic
+	defer func() { deterministic = deterministicReal }()
+	deterministic = true
+
+	for _, lesson := range List() {
+		if _, ok := skippedGoldenLessons[lesson.Name]; ok {
+			continue
+		}
+		diff, checked, err := checkLesson(lesson)
+		if err != nil {
+			t.Errorf("checkLesson(%v) failed: %v", lesson.Name, err)
+		}
+		if checked && diff != "" {
+			t.Errorf("lesson %v output differs from its comments:\n%v", lesson.Name, diff)
+		}
+	}
+}
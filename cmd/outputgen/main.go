@@ -0,0 +1,422 @@
+// outputgen runs every lesson and inserts
+// what each of its blocks printed as comments
+// go generate
+// lessons with unstable output can be skipped
+// go run ./cmd/outputgen -skip=maps,channels
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+func main() {
+	skip := flag.String("skip", "", "comma separated lessons to leave alone")
+	flag.Parse()
+
+	skipped := make(map[string]bool)
+	for _, name := range strings.Split(*skip, ",") {
+		skipped[name] = true
+	}
+
+	if err := generate(".", skipped); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// a source file of the lessons package
+type sourceFile struct {
+	path     string
+	content  []byte
+	fileSet  *token.FileSet
+	parsed   *ast.File
+	lessons  map[string]*ast.FuncDecl
+	inserted []insertion
+}
+
+type insertion struct {
+	offset int
+	text   string
+}
+
+// a block starts with a commented statement
+// and runs until the next one
+type block struct {
+	file   *sourceFile
+	end    int
+	indent string
+	output strings.Builder
+}
+
+func generate(dir string, skipped map[string]bool) error {
+	files, err := parseFiles(dir)
+	if err != nil {
+		return err
+	}
+
+	// comments from a previous run
+	// are removed then everything is parsed again
+	for _, file := range files {
+		file.content = stripOutputs(file)
+	}
+	if err := reparse(files); err != nil {
+		return err
+	}
+
+	blocks := instrument(files)
+	outputs, err := runLessons(dir, files, skipped)
+	if err != nil {
+		return err
+	}
+
+	// splitting the outputs on the markers
+	marker := regexp.MustCompile("\x1eoutputgen:([0-9]+)\n")
+	for _, output := range outputs {
+		matches := marker.FindAllStringSubmatchIndex(output, -1)
+		for i, match := range matches {
+			id, _ := strconv.Atoi(output[match[2]:match[3]])
+			end := len(output)
+			if i+1 < len(matches) {
+				end = matches[i+1][0]
+			}
+			blocks[id].output.WriteString(output[match[1]:end])
+		}
+	}
+
+	for _, file := range files {
+		file.inserted = nil
+	}
+	for _, block := range blocks {
+		if block.output.Len() == 0 {
+			continue
+		}
+		text := "\n" + block.indent + "// Output:"
+		for _, line := range strings.Split(strings.TrimSuffix(block.output.String(), "\n"), "\n") {
+			text += "\n" + strings.TrimRight(block.indent+"// "+line, " ")
+		}
+		block.file.inserted = append(block.file.inserted, insertion{block.end, text})
+	}
+
+	for _, file := range files {
+		if len(file.lessons) == 0 {
+			continue
+		}
+		formatted, err := format.Source(insert(file.content, file.inserted))
+		if err != nil {
+			return fmt.Errorf("while formatting %v: %w", file.path, err)
+		}
+		original, err := os.ReadFile(file.path)
+		if err != nil {
+			return err
+		}
+		if bytes.Equal(original, formatted) {
+			continue
+		}
+		if err := os.WriteFile(file.path, formatted, 0644); err != nil {
+			return err
+		}
+		fmt.Printf("outputgen: wrote %v\n", file.path)
+	}
+	return nil
+}
+
+// the go files of the package
+// test files excluded
+func parseFiles(dir string) ([]*sourceFile, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	var files []*sourceFile
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, &sourceFile{path: path, content: content})
+	}
+	return files, reparse(files)
+}
+
+func reparse(files []*sourceFile) error {
+	names := make(map[string]string)
+	for _, file := range files {
+		file.fileSet = token.NewFileSet()
+		parsed, err := parser.ParseFile(file.fileSet, file.path, file.content, parser.ParseComments)
+		if err != nil {
+			return err
+		}
+		file.parsed = parsed
+		for function, name := range registeredLessons(parsed) {
+			names[function] = name
+		}
+	}
+
+	for _, file := range files {
+		file.lessons = make(map[string]*ast.FuncDecl)
+		for _, declaration := range file.parsed.Decls {
+			function, ok := declaration.(*ast.FuncDecl)
+			if !ok || function.Recv != nil {
+				continue
+			}
+			if name, ok := names[function.Name.Name]; ok {
+				file.lessons[name] = function
+			}
+		}
+	}
+	return nil
+}
+
+// finding the Lesson{...} literals
+// including the ones of a []Lesson{...}
+// returns lesson names by function name
+func registeredLessons(parsed *ast.File) map[string]string {
+	names := make(map[string]string)
+	ast.Inspect(parsed, func(node ast.Node) bool {
+		literal, ok := node.(*ast.CompositeLit)
+		if !ok {
+			return true
+		}
+		if typ, ok := literal.Type.(*ast.Ident); ok && typ.Name == "Lesson" {
+			addLesson(names, literal)
+			return true
+		}
+		array, ok := literal.Type.(*ast.ArrayType)
+		if !ok {
+			return true
+		}
+		if typ, ok := array.Elt.(*ast.Ident); !ok || typ.Name != "Lesson" {
+			return true
+		}
+		for _, element := range literal.Elts {
+			if lesson, ok := element.(*ast.CompositeLit); ok && lesson.Type == nil {
+				addLesson(names, lesson)
+			}
+		}
+		return true
+	})
+	return names
+}
+
+func addLesson(names map[string]string, literal *ast.CompositeLit) {
+	var name, run ast.Expr
+	for i, element := range literal.Elts {
+		if keyValue, ok := element.(*ast.KeyValueExpr); ok {
+			switch key := keyValue.Key.(*ast.Ident); key.Name {
+			case "Name":
+				name = keyValue.Value
+			case "Run":
+				run = keyValue.Value
+			}
+			continue
+		}
+		switch i {
+		case 0:
+			name = element
+		case 2:
+			run = element
+		}
+	}
+
+	nameLiteral, ok := name.(*ast.BasicLit)
+	if !ok {
+		return
+	}
+	runIdent, ok := run.(*ast.Ident)
+	if !ok {
+		return
+	}
+	if unquoted, err := strconv.Unquote(nameLiteral.Value); err == nil {
+		names[runIdent.Name] = unquoted
+	}
+}
+
+// removing the output comments
+// found inside the lesson functions
+func stripOutputs(file *sourceFile) []byte {
+	lines := strings.SplitAfter(string(file.content), "\n")
+	removed := make(map[int]bool)
+	for _, function := range file.lessons {
+		for _, group := range file.parsed.Comments {
+			if group.Pos() < function.Body.Pos() || group.End() > function.Body.End() {
+				continue
+			}
+			if !strings.HasPrefix(group.Text(), "Output:") {
+				continue
+			}
+			start := file.fileSet.Position(group.Pos()).Line
+			end := file.fileSet.Position(group.End()).Line
+			for line := start; line <= end; line++ {
+				removed[line-1] = true
+			}
+		}
+	}
+
+	var stripped strings.Builder
+	for i, line := range lines {
+		if !removed[i] {
+			stripped.WriteString(line)
+		}
+	}
+	return []byte(stripped.String())
+}
+
+// inserting a marker call in front
+// of the first statement of each block
+func instrument(files []*sourceFile) []*block {
+	var blocks []*block
+	for _, file := range files {
+		for _, function := range file.lessons {
+			statements := function.Body.List
+			for i, statement := range statements {
+				if i > 0 && !commented(file, statement) {
+					continue
+				}
+
+				// the block ends before
+				// the next commented statement
+				last := statement
+				for _, next := range statements[i+1:] {
+					if commented(file, next) {
+						break
+					}
+					last = next
+				}
+
+				start := file.fileSet.Position(statement.Pos()).Offset
+				end := file.fileSet.Position(last.End()).Offset
+				if newline := bytes.IndexByte(file.content[end:], '\n'); newline >= 0 {
+					end += newline
+				}
+				lineStart := bytes.LastIndexByte(file.content[:start], '\n') + 1
+
+				file.inserted = append(file.inserted, insertion{start, fmt.Sprintf("outputgenMarker(%d); ", len(blocks))})
+				blocks = append(blocks, &block{
+					file:   file,
+					end:    end,
+					indent: string(file.content[lineStart:start]),
+				})
+			}
+		}
+	}
+	return blocks
+}
+
+func commented(file *sourceFile, statement ast.Stmt) bool {
+	line := file.fileSet.Position(statement.Pos()).Line
+	for _, group := range file.parsed.Comments {
+		if file.fileSet.Position(group.End()).Line == line-1 {
+			return true
+		}
+	}
+	return false
+}
+
+func insert(content []byte, insertions []insertion) []byte {
+	sort.Slice(insertions, func(i, j int) bool { return insertions[i].offset > insertions[j].offset })
+	result := append([]byte(nil), content...)
+	for _, insertion := range insertions {
+		result = append(result[:insertion.offset], append([]byte(insertion.text), result[insertion.offset:]...)...)
+	}
+	return result
+}
+
+const markerSource = `package main
+
+import "fmt"
+
+func outputgenMarker(id int) {
+	fmt.Printf("\x1eoutputgen:%d\n", id)
+}
+`
+
+// building the instrumented package
+// without touching the sources
+// using an overlay of replaced files
+// then running every lesson on its own
+func runLessons(dir string, files []*sourceFile, skipped map[string]bool) (map[string]string, error) {
+	temp, err := os.MkdirTemp("", "outputgen")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(temp)
+
+	overlay := struct{ Replace map[string]string }{make(map[string]string)}
+	for i, file := range files {
+		path, err := filepath.Abs(file.path)
+		if err != nil {
+			return nil, err
+		}
+		replaced := filepath.Join(temp, fmt.Sprintf("%d.go", i))
+		if err := os.WriteFile(replaced, insert(file.content, file.inserted), 0644); err != nil {
+			return nil, err
+		}
+		overlay.Replace[path] = replaced
+	}
+
+	markerPath, err := filepath.Abs(filepath.Join(dir, "zz_outputgen_marker.go"))
+	if err != nil {
+		return nil, err
+	}
+	overlay.Replace[markerPath] = filepath.Join(temp, "marker.go")
+	if err := os.WriteFile(overlay.Replace[markerPath], []byte(markerSource), 0644); err != nil {
+		return nil, err
+	}
+
+	overlayJSON, err := json.Marshal(overlay)
+	if err != nil {
+		return nil, err
+	}
+	overlayPath := filepath.Join(temp, "overlay.json")
+	if err := os.WriteFile(overlayPath, overlayJSON, 0644); err != nil {
+		return nil, err
+	}
+
+	binary := filepath.Join(temp, "lessons")
+	build := exec.Command("go", "build", "-overlay", overlayPath, "-o", binary, ".")
+	build.Dir = dir
+	build.Stderr = os.Stderr
+	if err := build.Run(); err != nil {
+		return nil, fmt.Errorf("while building the instrumented lessons: %w", err)
+	}
+
+	// the lessons run with a throwaway home
+	// so they do not record any progress
+	// and deterministically so their goroutines
+	// print in a stable order
+	outputs := make(map[string]string)
+	for _, file := range files {
+		for name := range file.lessons {
+			if skipped[name] {
+				continue
+			}
+			run := exec.Command(binary, "-lesson="+name, "-deterministic")
+			run.Dir = dir
+			run.Env = append(os.Environ(), "HOME="+temp, "XDG_CONFIG_HOME="+temp)
+			run.Stderr = os.Stderr
+			output, err := run.Output()
+			if err != nil {
+				return nil, fmt.Errorf("while running lesson %v: %w", name, err)
+			}
+			outputs[name] = string(output)
+		}
+	}
+	return outputs, nil
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+const lessonSource = `package main
+
+func init() {
+	RegisterChapter(Chapter{1, "First", []Lesson{
+		{"first", "the first lesson", firstLesson, nil},
+	}})
+	RegisterChapter(Chapter{2, "Second", []Lesson{
+		Lesson{Name: "second", Run: secondLesson},
+	}})
+}
+
+func firstLesson() {
+
+	// printing
+	fmt.Println("a")
+	// Output:
+	// a
+
+	// printing again
+	fmt.Println("b")
+	fmt.Println("c")
+}
+
+func secondLesson() {
+}
+`
+
+func TestStripAndInstrument(t *testing.T) {
+	files := []*sourceFile{{path: filepath.Join(t.TempDir(), "lesson.go"), content: []byte(lessonSource)}}
+	if err := reparse(files); err != nil {
+		t.Fatal(err)
+	}
+	if len(files[0].lessons) != 2 || files[0].lessons["first"] == nil || files[0].lessons["second"] == nil {
+		t.Fatalf("found lessons %v, want first and second", files[0].lessons)
+	}
+
+	files[0].content = stripOutputs(files[0])
+	if err := reparse(files); err != nil {
+		t.Fatal(err)
+	}
+	delete(files[0].lessons, "second")
+
+	blocks := instrument(files)
+	if len(blocks) != 2 {
+		t.Fatalf("found %v blocks, want 2", len(blocks))
+	}
+
+	want := `package main
+
+func init() {
+	RegisterChapter(Chapter{1, "First", []Lesson{
+		{"first", "the first lesson", firstLesson, nil},
+	}})
+	RegisterChapter(Chapter{2, "Second", []Lesson{
+		Lesson{Name: "second", Run: secondLesson},
+	}})
+}
+
+func firstLesson() {
+
+	// printing
+	outputgenMarker(0); fmt.Println("a")
+
+	// printing again
+	outputgenMarker(1); fmt.Println("b")
+	fmt.Println("c")
+}
+
+func secondLesson() {
+}
+`
+	if got := string(insert(files[0].content, files[0].inserted)); got != want {
+		t.Errorf("instrumented source\n%v\nwant\n%v", got, want)
+	}
+}
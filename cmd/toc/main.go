@@ -0,0 +1,239 @@
+// toc prints a numbered table of contents of the lessons
+// made of their chapters and of the comments
+// introducing each section of their code
+// go run ./cmd/toc
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+func main() {
+	dir := flag.String("dir", ".", "directory of the lessons package")
+	flag.Parse()
+
+	chapters, err := parseChapters(*dir)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	printContents(os.Stdout, chapters)
+}
+
+type chapter struct {
+	order   int
+	title   string
+	lessons []lesson
+}
+
+type lesson struct {
+	name        string
+	description string
+	function    string
+	position    token.Position
+	sections    []section
+}
+
+type section struct {
+	title    string
+	position token.Position
+}
+
+func parseChapters(dir string) ([]chapter, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	fileSet := token.NewFileSet()
+	var files []*ast.File
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fileSet, path, nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+
+	var chapters []chapter
+	for _, file := range files {
+		chapters = append(chapters, registeredChapters(file)...)
+	}
+	sort.SliceStable(chapters, func(i, j int) bool { return chapters[i].order < chapters[j].order })
+
+	// locating the function of every lesson
+	functions := make(map[string]*ast.FuncDecl)
+	comments := make(map[*ast.FuncDecl][]*ast.CommentGroup)
+	for _, file := range files {
+		for _, declaration := range file.Decls {
+			if function, ok := declaration.(*ast.FuncDecl); ok && function.Recv == nil && function.Body != nil {
+				functions[function.Name.Name] = function
+				comments[function] = file.Comments
+			}
+		}
+	}
+
+	for c := range chapters {
+		for l := range chapters[c].lessons {
+			lesson := &chapters[c].lessons[l]
+			function, ok := functions[lesson.function]
+			if !ok {
+				continue
+			}
+			lesson.position = fileSet.Position(function.Pos())
+			lesson.sections = sections(fileSet, function, comments[function])
+		}
+	}
+	return chapters, nil
+}
+
+// finding the RegisterChapter(Chapter{...}) calls
+// with their positional or keyed fields
+// chapters built at run time like the plugin ones
+// have no literal order or title and are skipped
+func registeredChapters(file *ast.File) []chapter {
+	var chapters []chapter
+	ast.Inspect(file, func(node ast.Node) bool {
+		call, ok := node.(*ast.CallExpr)
+		if !ok || len(call.Args) != 1 {
+			return true
+		}
+		if function, ok := call.Fun.(*ast.Ident); !ok || function.Name != "RegisterChapter" {
+			return true
+		}
+		literal, ok := call.Args[0].(*ast.CompositeLit)
+		if !ok {
+			return true
+		}
+
+		var found chapter
+		for i, value := range fields(literal, "Order", "Title", "Lessons") {
+			switch i {
+			case 0:
+				order, err := strconv.Atoi(basicValue(value))
+				if err != nil {
+					return true
+				}
+				found.order = order
+			case 1:
+				if _, ok := value.(*ast.BasicLit); !ok {
+					return true
+				}
+				found.title = basicValue(value)
+			case 2:
+				lessons, ok := value.(*ast.CompositeLit)
+				if !ok {
+					continue
+				}
+				for _, element := range lessons.Elts {
+					if lessonLiteral, ok := element.(*ast.CompositeLit); ok {
+						found.lessons = append(found.lessons, registeredLesson(lessonLiteral))
+					}
+				}
+			}
+		}
+		chapters = append(chapters, found)
+		return true
+	})
+	return chapters
+}
+
+func registeredLesson(literal *ast.CompositeLit) lesson {
+	var found lesson
+	for i, value := range fields(literal, "Name", "Description", "Run") {
+		switch i {
+		case 0:
+			found.name = basicValue(value)
+		case 1:
+			found.description = basicValue(value)
+		case 2:
+			if ident, ok := value.(*ast.Ident); ok {
+				found.function = ident.Name
+			}
+		}
+	}
+	return found
+}
+
+// the values of the named fields of a literal
+// in the order of the names
+func fields(literal *ast.CompositeLit, names ...string) []ast.Expr {
+	values := make([]ast.Expr, len(names))
+	for i, element := range literal.Elts {
+		if keyValue, ok := element.(*ast.KeyValueExpr); ok {
+			for n, name := range names {
+				if key, ok := keyValue.Key.(*ast.Ident); ok && key.Name == name {
+					values[n] = keyValue.Value
+				}
+			}
+			continue
+		}
+		if i < len(values) {
+			values[i] = element
+		}
+	}
+	return values
+}
+
+func basicValue(value ast.Expr) string {
+	literal, ok := value.(*ast.BasicLit)
+	if !ok {
+		return ""
+	}
+	if literal.Kind == token.STRING {
+		unquoted, _ := strconv.Unquote(literal.Value)
+		return unquoted
+	}
+	return literal.Value
+}
+
+// a section is introduced by the comment
+// right above a statement of the lesson
+// its lines are joined into a single title
+// generated output comments are not sections
+func sections(fileSet *token.FileSet, function *ast.FuncDecl, comments []*ast.CommentGroup) []section {
+	var found []section
+	for _, statement := range function.Body.List {
+		line := fileSet.Position(statement.Pos()).Line
+		for _, group := range comments {
+			if fileSet.Position(group.End()).Line != line-1 {
+				continue
+			}
+			title := strings.Join(strings.Fields(group.Text()), " ")
+			if strings.HasPrefix(title, "Output:") {
+				continue
+			}
+			found = append(found, section{title, fileSet.Position(group.Pos())})
+		}
+	}
+	return found
+}
+
+func printContents(w io.Writer, chapters []chapter) {
+	for c, chapter := range chapters {
+		fmt.Fprintf(w, "%d. %v\n", c+1, chapter.title)
+		for l, lesson := range chapter.lessons {
+			fmt.Fprintf(w, "  %d.%d. %v: %v %v\n", c+1, l+1, lesson.name, lesson.description, anchor(lesson.position))
+			for s, section := range lesson.sections {
+				fmt.Fprintf(w, "    %d.%d.%d. %v %v\n", c+1, l+1, s+1, section.title, anchor(section.position))
+			}
+		}
+	}
+}
+
+func anchor(position token.Position) string {
+	return fmt.Sprintf("%v:%v", filepath.Base(position.Filename), position.Line)
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const lessonSource = `package main
+
+func init() {
+	RegisterChapter(Chapter{2, "Second", []Lesson{
+		Lesson{Name: "second", Description: "the second lesson", Run: secondLesson},
+	}})
+	RegisterChapter(Chapter{1, "First", []Lesson{
+		{"first", "the first lesson", firstLesson, nil},
+	}})
+}
+
+func loadPacks(titles []string, lessons []Lesson) {
+	for i, title := range titles {
+		RegisterChapter(Chapter{100 + i, title, lessons})
+	}
+}
+
+func firstLesson() {
+
+	// printing
+	// a letter
+	fmt.Println("a")
+	// Output:
+	// a
+
+	// printing again
+	fmt.Println("b")
+	fmt.Println("c")
+}
+
+func secondLesson() {
+}
+`
+
+func TestContents(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "lesson.go"), []byte(lessonSource), 0644); err != nil {
+		t.Fatal(err)
+	}
+	chapters, err := parseChapters(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var contents strings.Builder
+	printContents(&contents, chapters)
+	want := `1. First
+  1.1. first: the first lesson lesson.go:18
+    1.1.1. printing a letter lesson.go:20
+    1.1.2. printing again lesson.go:26
+2. Second
+  2.1. second: the second lesson lesson.go:31
+`
+	if contents.String() != want {
+		t.Errorf("contents = %q, want %q", contents.String(), want)
+	}
+}
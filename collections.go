@@ -0,0 +1,482 @@
+package main
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+func init() {
+	RegisterChapter(Chapter{2, "Collections", []Lesson{
+		{"arrays", "fixed length arrays", arraysLesson, []string{"array", "literal"}, []string{"types"}, []string{"beginner", "collections"}},
+		{"slices", "auto growing slices", slicesLesson, []string{"slice", "append", "copy", "make", "capacity"}, []string{"arrays"}, []string{"beginner", "collections"}},
+		{"slices-package", "the slices package", slicesPackageLesson, []string{"slices", "slices.Sort", "slices.Contains", "BinarySearch", "slices.Insert", "slices.Delete"}, []string{"slices", "generics"}, []string{"intermediate", "collections", "generics"}},
+		{"maps", "hash tables and ordered maps", mapsLesson, []string{"map", "hash table", "delete", "OrderedMap", "maps.Keys", "maps.Clone", "maps.Equal", "maps.DeleteFunc"}, []string{"slices"}, []string{"beginner", "collections", "generics"}},
+		{"copies", "shallow and deep copies", copiesLesson, []string{"copy", "shallow copy", "deep copy", "Clone", "slices.Clone", "maps.Clone", "aliasing"}, []string{"maps", "structures"}, []string{"intermediate", "collections"}},
+		{"nil-collections", "nil slices, maps and channels", nilCollectionsLesson, []string{"nil", "nil slice", "nil map", "nil channel", "panic", "append", "make"}, []string{"maps", "panics"}, []string{"beginner", "collections"}},
+	}})
+}
+
+// maps do not remember the order keys were set in
+// their iteration order is randomized on purpose
+// so keeping track of it needs a separate slice
+type OrderedMap[K comparable, V any] struct {
+	values map[K]V
+	keys   []K
+}
+
+func NewOrderedMap[K comparable, V any]() *OrderedMap[K, V] {
+	return &OrderedMap[K, V]{values: make(map[K]V)}
+}
+
+// setting an existing key updates its value
+// but keeps its original position
+func (m *OrderedMap[K, V]) Set(key K, value V) {
+	if _, ok := m.values[key]; !ok {
+		m.keys = append(m.keys, key)
+	}
+	m.values[key] = value
+}
+
+func (m *OrderedMap[K, V]) Get(key K) (V, bool) {
+	value, ok := m.values[key]
+	return value, ok
+}
+
+func (m *OrderedMap[K, V]) Delete(key K) {
+	if _, ok := m.values[key]; !ok {
+		return
+	}
+	delete(m.values, key)
+	i := slices.Index(m.keys, key)
+	m.keys = slices.Delete(m.keys, i, i+1)
+}
+
+// keys are returned in insertion order
+func (m *OrderedMap[K, V]) Keys() []K {
+	keys := make([]K, len(m.keys))
+	copy(keys, m.keys)
+	return keys
+}
+
+// running something that may panic
+// and returning what was recovered
+// nil when nothing panicked
+func recovered(run func()) (value interface{}) {
+	defer func() {
+		value = recover()
+	}()
+	run()
+	return nil
+}
+
+// a struct holding a slice and a map
+// holds their headers, not their contents
+type Recipe struct {
+	Name        string
+	Ingredients []string
+	Quantities  map[string]int
+}
+
+// a deep copy clones every slice and map
+// so nothing is shared with the original
+// slices.Clone and maps.Clone are shallow themselves
+// elements holding pointers need cloning in turn
+func (r Recipe) Clone() Recipe {
+	return Recipe{
+		Name:        r.Name,
+		Ingredients: slices.Clone(r.Ingredients),
+		Quantities:  maps.Clone(r.Quantities),
+	}
+}
+
+// 8192 ints take 64KB
+const readingsCount = 8192
+
+// an array is a value
+// passing it copies every element
+// even to read a single one
+// noinline keeps the compiler from eliding the copy
+//
+//go:noinline
+func lastOfArray(readings [readingsCount]int) int {
+	return readings[len(readings)-1]
+}
+
+// a slice is a small header
+// pointing to the elements
+// passing it copies the header only
+//
+//go:noinline
+func lastOfSlice(readings []int) int {
+	return readings[len(readings)-1]
+}
+
+func arraysLesson() {
+
+	// arrays have a fixed length
+	trace()
+	var array [2]int
+	fmt.Printf("array of %v elements\n", len(array))
+	// Output:
+	// array of 2 elements
+
+	// array literals
+	trace()
+	_ = [3]int{1, 2, 3}
+	_ = [...]int{1, 2, 3, 4}
+	_ = [...]int{2: 10, 4: 20}
+
+	// arrays are copied when assigned
+	// changing the copy leaves the original alone
+	trace()
+	original := [3]int{1, 2, 3}
+	copied := original
+	copied[0] = 100
+	fmt.Printf("original: %v, copied: %v\n", original, copied)
+	// Output:
+	// original: [1 2 3], copied: [100 2 3]
+
+	// and when passed to a function
+	// a slice of the array shares its elements instead
+	// go test -bench LastOf -benchmem
+	trace()
+	var readings [readingsCount]int
+	readings[readingsCount-1] = 42
+	fmt.Printf("array: %v, slice: %v\n", lastOfArray(readings), lastOfSlice(readings[:]))
+	// Output:
+	// array: 42, slice: 42
+}
+
+func slicesLesson() {
+
+	// slices have an auto growing length
+	// they keep track of an array and its capacity
+	trace()
+	var slice []int
+	fmt.Printf("slice of %v elements and a capacity for %v\n", len(slice), cap(slice))
+	// Output:
+	// slice of 0 elements and a capacity for 0
+
+	// slice literals
+	trace()
+	_ = []int{}
+	_ = []int{1, 2, 3, 4}
+	_ = []int{2: 10, 4: 20}
+
+	// appending values
+	// can reallocate the array to a bigger location
+	// must be recaptured
+	trace()
+	slice = append(slice, 1)
+	slice = append(slice, 2)
+	slice = append(slice, 3)
+	fmt.Printf("appended slice %v\n", slice)
+	// Output:
+	// appended slice [1 2 3]
+
+	// selecting values
+	trace()
+	fmt.Printf("selected slice %v\n", slice[1:])
+	// Output:
+	// selected slice [2 3]
+
+	// modifying values
+	trace()
+	slice[0] = 10
+	slice[1] = 20
+	slice[2] = 30
+	fmt.Printf("modified slice %v\n", slice)
+	// Output:
+	// modified slice [10 20 30]
+
+	// removing values
+	trace()
+	copy(slice[1:], slice[2:])
+	slice = slice[:len(slice)-1]
+	fmt.Printf("removed slice %v\n", slice)
+	// Output:
+	// removed slice [10 30]
+
+	// slices can be built with a
+	// predefined length and capacity
+	trace()
+	slice = make([]int, 5, 1000)
+	fmt.Printf("slice of %v elements and a capacity for %v\n", len(slice), cap(slice))
+	// Output:
+	// slice of 5 elements and a capacity for 1000
+
+	// selected slices are not stable
+	// changes in the source can be seen
+	// unless the source gets reallocated
+	// probably make a copy
+	trace()
+	source := []int{1}
+	selectedSlice := source[:]
+	source[0] = 2
+	fmt.Printf("selected slice %v\n", selectedSlice)
+	source = append(source, 0)
+	source[0] = 3
+	fmt.Printf("selected slice %v\n", selectedSlice)
+	// Output:
+	// selected slice [2]
+	// selected slice [2]
+}
+
+func slicesPackageLesson() {
+
+	// looking for values
+	// instead of looping by hand
+	trace()
+	languages := []string{"go", "rust", "zig"}
+	fmt.Printf("contains rust: %v, index of zig: %v\n", slices.Contains(languages, "rust"), slices.Index(languages, "zig"))
+	// Output:
+	// contains rust: true, index of zig: 2
+
+	// sorting in place
+	trace()
+	numbers := []int{30, 10, 20}
+	slices.Sort(numbers)
+	fmt.Printf("sorted: %v\n", numbers)
+	// Output:
+	// sorted: [10 20 30]
+
+	// sorting with a comparison function
+	// returning a negative number, zero or a positive number
+	trace()
+	slices.SortFunc(languages, func(a, b string) int {
+		return len(b) - len(a)
+	})
+	fmt.Printf("longest first: %v\n", languages)
+	// Output:
+	// longest first: [rust zig go]
+
+	// searching a sorted slice
+	// also tells where a missing value would go
+	trace()
+	position, found := slices.BinarySearch(numbers, 20)
+	fmt.Printf("20 at %v: %v\n", position, found)
+	position, found = slices.BinarySearch(numbers, 25)
+	fmt.Printf("25 at %v: %v\n", position, found)
+	// Output:
+	// 20 at 1: true
+	// 25 at 2: false
+
+	// cloning gives an independent copy
+	trace()
+	clone := slices.Clone(numbers)
+	clone[0] = 0
+	fmt.Printf("original: %v, clone: %v\n", numbers, clone)
+	// Output:
+	// original: [10 20 30], clone: [0 20 30]
+
+	// compacting removes consecutive duplicates
+	// sort first to remove them all
+	trace()
+	words := strings.Fields("a a b a c c")
+	fmt.Printf("compacted: %v\n", slices.Compact(slices.Clone(words)))
+	slices.Sort(words)
+	fmt.Printf("unique: %v\n", slices.Compact(words))
+	// Output:
+	// compacted: [a b a c]
+	// unique: [a b c]
+
+	// inserting and deleting
+	// replace the copy and append juggling
+	trace()
+	numbers = slices.Insert(numbers, 1, 15)
+	fmt.Printf("inserted: %v\n", numbers)
+	numbers = slices.Delete(numbers, 2, 3)
+	fmt.Printf("deleted: %v\n", numbers)
+	// Output:
+	// inserted: [10 15 20 30]
+	// deleted: [10 15 30]
+}
+
+func mapsLesson() {
+
+	// maps are hash tables
+	trace()
+	var nameById = make(map[int]string)
+
+	// map literals
+	trace()
+	_ = map[int]string{}
+	_ = map[int]string{1: "Alice", 2: "Bob"}
+
+	// setting values
+	trace()
+	nameById[100] = "Alice"
+	nameById[200] = "Bob"
+	nameById[300] = "Carl"
+
+	// looking up values
+	trace()
+	if name, ok := nameById[100]; ok {
+		fmt.Printf("name: %v\n", name)
+	}
+
+	// iterating over values
+	// order is not guaranteed
+	trace()
+	for id, name := range nameById {
+		fmt.Printf("id: %v, name: %v\n", id, name)
+	}
+
+	// removing values
+	trace()
+	delete(nameById, 300)
+
+	// the maps package gives the keys
+	// and the values as iterators
+	// sorting them gives a stable order
+	trace()
+	ids := slices.Sorted(maps.Keys(nameById))
+	names := slices.Sorted(maps.Values(nameById))
+	fmt.Printf("ids: %v, names: %v\n", ids, names)
+
+	// cloning gives an independent copy
+	// equal compares the keys and the values
+	trace()
+	clonedNameById := maps.Clone(nameById)
+	fmt.Printf("equal: %v\n", maps.Equal(nameById, clonedNameById))
+	clonedNameById[400] = "Dave"
+	fmt.Printf("equal after adding: %v\n", maps.Equal(nameById, clonedNameById))
+
+	// deleting the entries a function matches
+	// instead of deleting while ranging
+	trace()
+	maps.DeleteFunc(clonedNameById, func(id int, name string) bool {
+		return id > 150
+	})
+	fmt.Printf("cloned: %v\n", clonedNameById)
+
+	// iterating in insertion order
+	// requires tracking the keys
+	trace()
+	orderedNameById := NewOrderedMap[int, string]()
+	orderedNameById.Set(300, "Carl")
+	orderedNameById.Set(100, "Alice")
+	orderedNameById.Set(200, "Bob")
+	for _, id := range orderedNameById.Keys() {
+		name, _ := orderedNameById.Get(id)
+		fmt.Printf("id: %v, name: %v\n", id, name)
+	}
+}
+
+func nilCollectionsLesson() {
+
+	// a nil slice has a length of 0
+	// ranging over it does nothing
+	// and append allocates it
+	trace()
+	var numbers []int
+	fmt.Printf("nil: %v, len: %v\n", numbers == nil, len(numbers))
+	for range numbers {
+		fmt.Println("never printed")
+	}
+	numbers = append(numbers, 1)
+	fmt.Printf("appended: %v\n", numbers)
+	// Output:
+	// nil: true, len: 0
+	// appended: [1]
+
+	// indexing past the length panics
+	// nil or not
+	trace()
+	var empty []int
+	fmt.Println(recovered(func() { _ = empty[0] }))
+	// Output:
+	// runtime error: index out of range [0] with length 0
+
+	// a nil map can be read
+	// every key is missing
+	trace()
+	var ages map[string]int
+	age, ok := ages["alice"]
+	fmt.Printf("len: %v, age: %v, ok: %v\n", len(ages), age, ok)
+	delete(ages, "alice")
+	// Output:
+	// len: 0, age: 0, ok: false
+
+	// writing to a nil map panics
+	// it must be made first
+	trace()
+	fmt.Println(recovered(func() { ages["alice"] = 30 }))
+	ages = make(map[string]int)
+	ages["alice"] = 30
+	fmt.Printf("made: %v\n", ages)
+	// Output:
+	// assignment to entry in nil map
+	// made: map[alice:30]
+
+	// sending to or receiving from a nil channel
+	// blocks forever
+	// a select with a default shows it is not ready
+	trace()
+	var events chan string
+	select {
+	case events <- "click":
+		fmt.Println("sent")
+	default:
+		fmt.Println("sending would block forever")
+	}
+	select {
+	case event := <-events:
+		fmt.Println(event)
+	default:
+		fmt.Println("receiving would block forever")
+	}
+	// Output:
+	// sending would block forever
+	// receiving would block forever
+
+	// closing a nil channel panics
+	trace()
+	fmt.Println(recovered(func() { close(events) }))
+	// Output:
+	// close of nil channel
+}
+
+func copiesLesson() {
+
+	// assigning a struct copies its fields
+	// the name is independent
+	trace()
+	original := Recipe{"pancakes", []string{"flour", "milk"}, map[string]int{"flour": 200, "milk": 300}}
+	shallow := original
+	shallow.Name = "crepes"
+	fmt.Printf("original: %v, shallow: %v\n", original.Name, shallow.Name)
+	// Output:
+	// original: pancakes, shallow: crepes
+
+	// but the slice and the map are shared
+	// changes through the copy show in the original
+	trace()
+	shallow.Ingredients[1] = "water"
+	shallow.Quantities["flour"] = 100
+	fmt.Printf("original: %v %v\n", original.Ingredients, original.Quantities)
+	// Output:
+	// original: [flour water] map[flour:100 milk:300]
+
+	// appending may or may not reallocate
+	// so whether it is shared depends on the capacity
+	trace()
+	shallow.Ingredients = append(shallow.Ingredients, "eggs")
+	shallow.Ingredients[0] = "buckwheat"
+	fmt.Printf("original: %v, shallow: %v\n", original.Ingredients, shallow.Ingredients)
+	// Output:
+	// original: [flour water], shallow: [buckwheat water eggs]
+
+	// a deep copy shares nothing
+	trace()
+	deep := original.Clone()
+	deep.Ingredients[0] = "rice flour"
+	deep.Quantities["milk"] = 0
+	fmt.Printf("original: %v %v\n", original.Ingredients, original.Quantities)
+	fmt.Printf("deep: %v %v\n", deep.Ingredients, deep.Quantities)
+	// Output:
+	// original: [flour water] map[flour:100 milk:300]
+	// deep: [rice flour water] map[flour:100 milk:0]
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestOrderedMapKeepsInsertionOrder(t *testing.T) {
+	orderedMap := NewOrderedMap[string, int]()
+	orderedMap.Set("c", 1)
+	orderedMap.Set("a", 2)
+	orderedMap.Set("b", 3)
+
+	// updating does not move the key
+	orderedMap.Set("c", 10)
+
+	// deleting removes it from the order
+	orderedMap.Delete("a")
+	orderedMap.Delete("missing")
+
+	if got, want := orderedMap.Keys(), []string{"c", "b"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Keys() = %v, want %v", got, want)
+	}
+	if value, ok := orderedMap.Get("c"); !ok || value != 10 {
+		t.Errorf("Get(c) = %v, %v, want 10, true", value, ok)
+	}
+	if _, ok := orderedMap.Get("a"); ok {
+		t.Error("Get(a) found a deleted key")
+	}
+
+	// setting again appends at the end
+	orderedMap.Set("a", 4)
+	if got, want := orderedMap.Keys(), []string{"c", "b", "a"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Keys() = %v, want %v", got, want)
+	}
+}
+
+func TestRecovered(t *testing.T) {
+	if value := recovered(func() { panic("boom") }); value != "boom" {
+		t.Errorf("recovered(panic) = %v, want boom", value)
+	}
+	if value := recovered(func() {}); value != nil {
+		t.Errorf("recovered(nothing) = %v, want nil", value)
+	}
+	var ages map[string]int
+	if value := recovered(func() { ages["alice"] = 30 }); value == nil {
+		t.Error("writing to a nil map did not panic")
+	}
+}
+
+func TestRecipeCloneIsIndependent(t *testing.T) {
+	original := Recipe{"pancakes", []string{"flour"}, map[string]int{"flour": 200}}
+	clone := original.Clone()
+	if !reflect.DeepEqual(clone, original) {
+		t.Errorf("Clone() = %v, want %v", clone, original)
+	}
+
+	clone.Ingredients[0] = "rice"
+	clone.Quantities["flour"] = 0
+	if original.Ingredients[0] != "flour" || original.Quantities["flour"] != 200 {
+		t.Errorf("changing the clone changed the original to %v", original)
+	}
+}
+
+var lastOfReadings [readingsCount]int
+
+// reading one element of an array argument
+// still pays for copying all 8192 of them
+// nothing escapes so neither allocates
+// go test -bench LastOf -benchmem
+// BenchmarkLastOfArray    736786    1559 ns/op    0 B/op    0 allocs/op
+// BenchmarkLastOfSlice 607019853   2.026 ns/op    0 B/op    0 allocs/op
+func BenchmarkLastOfArray(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		lastOfArray(lastOfReadings)
+	}
+}
+
+func BenchmarkLastOfSlice(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		lastOfSlice(lastOfReadings[:])
+	}
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+// telling apart the titles, the narration
+// and what the lessons print with ansi colors
+// go run . -color=always
+// colors are disabled when stdout is not a terminal
+// or when NO_COLOR is set
+type color string
+
+const (
+	titleColor     color = "\x1b[1;33m"
+	narrationColor color = "\x1b[36m"
+	detailColor    color = "\x1b[2m"
+	outputColor    color = "\x1b[32m"
+	resetColor     color = "\x1b[0m"
+)
+
+var colored = false
+
+// the escape sequence switching to a color
+// nothing when colors are disabled
+func paint(c color) string {
+	if !colored {
+		return ""
+	}
+	return string(c)
+}
+
+// -color=auto|always|never
+func colorEnabled(mode string, file *os.File) (bool, error) {
+	switch mode {
+	case "always":
+		return true, nil
+	case "never":
+		return false, nil
+	case "auto":
+		if _, ok := os.LookupEnv("NO_COLOR"); ok {
+			return false, nil
+		}
+		return isTerminal(file), nil
+	}
+	return false, fmt.Errorf("unknown color mode %v, want auto, always or never", mode)
+}
+
+// terminals are character devices
+// pipes and files are not
+func isTerminal(file *os.File) bool {
+	info, err := file.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// the title printed before running a lesson
+// then switching to the color of its output
+func printTitle(lesson Lesson) {
+	if level >= normal {
+		fmt.Printf("%v%v: %v%v\n", paint(titleColor), lesson.Name, lesson.Description, paint(resetColor))
+	}
+	fmt.Print(paint(outputColor))
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"os"
+	"testing"
+)
+
+func TestColorEnabled(t *testing.T) {
+	file, err := os.Create(t.TempDir() + "/output")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	var tests = []struct {
+		mode string
+		want bool
+		fail bool
+	}{
+		{"always", true, false},
+		{"never", false, false},
+		{"auto", false, false},
+		{"sometimes", false, true},
+	}
+	for _, test := range tests {
+		got, err := colorEnabled(test.mode, file)
+		if got != test.want || (err != nil) != test.fail {
+			t.Errorf("colorEnabled(%v) = %v, %v, want %v and failure %v", test.mode, got, err, test.want, test.fail)
+		}
+	}
+}
+
+func TestColoredNarration(t *testing.T) {
+	coloredReal := colored
+	defer func() { colored = coloredReal }()
+
+	var tests = []struct {
+		colored bool
+		want    string
+	}{
+		{false, "variables: declaring variables\nnarration\n"},
+		{true, "\x1b[1;33mvariables: declaring variables\x1b[0m\n\x1b[32m\x1b[36mnarration\n\x1b[32m"},
+	}
+	for _, test := range tests {
+		colored = test.colored
+		output, err := captureOutput(func() {
+			printTitle(Lesson{Name: "variables", Description: "declaring variables"})
+			narrate("narration\n")
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if output != test.want {
+			t.Errorf("colored %v printed %q, want %q", test.colored, output, test.want)
+		}
+	}
+}
@@ -0,0 +1,850 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+func init() {
+	RegisterChapter(Chapter{8, "Concurrency", []Lesson{
+		{"goroutines", "starting goroutines", goroutinesLesson, []string{"go", "goroutine", "concurrency"}, []string{"functions"}, []string{"intermediate", "concurrency"}},
+		{"waitgroups", "waiting for goroutines with sync.WaitGroup", waitgroupsLesson, []string{"sync.WaitGroup", "WaitGroup", "Add", "Done", "Wait", "time.Sleep"}, []string{"goroutines"}, []string{"intermediate", "concurrency"}},
+		{"stacks", "counting goroutines and dumping their stacks", stacksLesson, []string{"runtime.Stack", "runtime.NumGoroutine", "stack trace", "goroutine dump", "SIGQUIT"}, []string{"goroutines"}, []string{"advanced", "concurrency"}},
+		{"channels", "exchanging messages over channels", channelsLesson, []string{"chan", "channel", "close", "range", "goroutine", "done channel", "struct{}", "broadcast"}, []string{"goroutines"}, []string{"intermediate", "concurrency"}},
+		{"loopvar", "per iteration loop variables", loopvarLesson, []string{"loop variable", "closure", "capture", "go 1.22", "goroutine"}, []string{"goroutines", "loops"}, []string{"intermediate", "concurrency", "functions"}},
+		{"select", "selecting from channels", selectLesson, []string{"select", "default", "non blocking", "buffered channel", "timeout", "time.After"}, []string{"channels"}, []string{"intermediate", "concurrency"}},
+		{"workerpools", "a fixed number of workers sharing a jobs channel", workerPoolsLesson, []string{"worker pool", "jobs", "results", "WaitGroup", "graceful shutdown", "generics"}, []string{"waitgroups", "select", "generics"}, []string{"intermediate", "concurrency"}},
+		{"fan-out", "fanning work out and merging it back in", fanOutLesson, []string{"fan out", "fan in", "Merge", "merge channels", "WaitGroup", "generics"}, []string{"workerpools"}, []string{"intermediate", "concurrency"}},
+		{"pipelines", "composing and cancelling pipeline stages", pipelinesLesson, []string{"pipeline", "stage", "generator", "filter", "context", "cancel", "goroutine leak"}, []string{"channels"}, []string{"advanced", "concurrency"}},
+		{"sync", "mutexes, sync.Map and sync.Once", syncLesson, []string{"sync.Mutex", "mutex", "sync.RWMutex", "sync.Map", "LoadOrStore", "sync.Once", "race"}, []string{"goroutines"}, []string{"advanced", "concurrency"}},
+		{"atomics", "lock free counters and flags with sync/atomic", atomicsLesson, []string{"sync/atomic", "atomic.Int64", "atomic.Bool", "CompareAndSwap", "lock free", "counter"}, []string{"sync"}, []string{"advanced", "concurrency"}},
+		{"cond", "waiting for conditions with sync.Cond", condLesson, []string{"sync.Cond", "Wait", "Signal", "Broadcast", "condition variable", "producer consumer", "bounded queue"}, []string{"sync"}, []string{"advanced", "concurrency"}},
+	}})
+}
+
+func goroutinesLesson() {
+
+	naps := make(chan string, 3)
+	takeNap := func() {
+		time.Sleep(10 * time.Millisecond)
+		naps <- "nap"
+	}
+
+	// functions invoked with
+	// go are executed concurrently
+	// returning right away would leave them running
+	// runConcurrently waits for them
+	// see the waitgroups lesson
+	trace()
+	runConcurrently(takeNap, takeNap, takeNap)
+	close(naps)
+	taken := 0
+	for range naps {
+		taken++
+	}
+	fmt.Printf("naps taken: %v\n", taken)
+	// Output:
+	// naps taken: 3
+}
+
+// sleeping and hoping the goroutines are done
+// is slow when they finish early
+// and wrong when they finish late
+// a WaitGroup counts them instead
+// Add before starting each one
+// Done when it returns
+// Wait blocks until the count drops to zero
+func runConcurrently(functions ...func()) {
+	var wg sync.WaitGroup
+	for _, function := range functions {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			function()
+		}()
+	}
+	wg.Wait()
+}
+
+func waitgroupsLesson() {
+
+	// waiting for every goroutine to finish
+	trace()
+	var wg sync.WaitGroup
+	results := make([]int, 3)
+	for i := range 3 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			time.Sleep(time.Duration(3-i) * 10 * time.Millisecond)
+			results[i] = i + 1
+		}()
+	}
+	wg.Wait()
+	fmt.Printf("results: %v\n", results)
+	// Output:
+	// results: [1 2 3]
+
+	// Add must be called before the go statement
+	// called inside the goroutine
+	// Wait may run first and see a count of zero
+	// since go 1.25 wg.Go does both the Add and the Done
+	trace()
+	var first, second string
+	runConcurrently(
+		func() { first = "first done" },
+		func() { second = "second done" },
+	)
+	fmt.Println(first, "and", second)
+	// Output:
+	// first done and second done
+
+	// a WaitGroup must not be copied
+	// pass a pointer to the functions that call Done
+	// go vet reports the copies
+	trace()
+	var counter sync.WaitGroup
+	markDone := func(wg *sync.WaitGroup) {
+		defer wg.Done()
+	}
+	counter.Add(1)
+	go markDone(&counter)
+	counter.Wait()
+	fmt.Println("all done")
+	// Output:
+	// all done
+}
+
+// a goroutine dump lists every goroutine
+// with its id, its state and its stack
+// the most recent call first
+// each call is followed by its file and line
+// keeping the first lines of each goroutine
+// is usually enough to see where it is stuck
+func goroutineDump(all bool, linesPerGoroutine int) string {
+	buffer := make([]byte, 1<<16)
+	dump := string(buffer[:runtime.Stack(buffer, all)])
+
+	var trimmed []string
+	for _, goroutine := range strings.Split(strings.TrimSpace(dump), "\n\n") {
+		lines := strings.Split(goroutine, "\n")
+		if len(lines) > linesPerGoroutine {
+			lines = append(lines[:linesPerGoroutine], "\t...")
+		}
+		trimmed = append(trimmed, strings.Join(lines, "\n"))
+	}
+	return strings.Join(trimmed, "\n\n")
+}
+
+func stacksLesson() {
+
+	// counting the running goroutines
+	// each goroutine says it started
+	// instead of sleeping and hoping it did
+	trace()
+	before := runtime.NumGoroutine()
+	blocked := make(chan bool)
+	var started sync.WaitGroup
+	for range 3 {
+		started.Add(1)
+		go func() {
+			started.Done()
+			<-blocked
+		}()
+	}
+	started.Wait()
+	fmt.Printf("goroutines: %v, then %v\n", before, runtime.NumGoroutine())
+
+	// the stack of the current goroutine
+	trace()
+	fmt.Println(goroutineDump(false, 5))
+
+	// the stacks of all the goroutines
+	// the same dump a crash or a SIGQUIT prints
+	// the blocked ones wait on a chan receive
+	// or are about to
+	trace()
+	fmt.Println(goroutineDump(true, 3))
+	close(blocked)
+}
+
+// the done channel carries no values
+// struct{} takes no memory
+// it is only ever closed
+func workUntilDone(done <-chan struct{}, id int, exited chan<- int) {
+	for {
+		select {
+		case <-done:
+			exited <- id
+			return
+		default:
+			// a small piece of work
+			// between two checks
+			time.Sleep(time.Millisecond)
+		}
+	}
+}
+
+func channelsLesson() {
+
+	// goroutines communicate by
+	// exchanging messages over channels
+	trace()
+	channel := make(chan int)
+
+	// both the sender and the receiver are blocked
+	// until a message is exchanged
+	trace()
+	sender := func() {
+		narrate("sending value 1\n")
+		channel <- 1
+	}
+
+	receiver := func() {
+		value := <-channel
+		fmt.Printf("received value %v\n", value)
+	}
+
+	runConcurrently(sender, receiver)
+	// Output:
+	// sending value 1
+	// received value 1
+
+	// a channel can be closed to signal
+	// no more messages will be sent
+	trace()
+	sender = func() {
+		narrate("closing channel\n")
+		close(channel)
+	}
+
+	receiver = func() {
+		if _, ok := <-channel; !ok {
+			fmt.Println("channel was closed")
+		}
+	}
+
+	runConcurrently(sender, receiver)
+	channel = make(chan int)
+	// Output:
+	// closing channel
+	// channel was closed
+
+	// closing a done channel is the way to cancel
+	// that came before the context package
+	// every worker selects on it between pieces of work
+	// see the cancellation lesson for ctx.Done()
+	trace()
+	done := make(chan struct{})
+	exited := make(chan int, 3)
+	var workers sync.WaitGroup
+	for id := 1; id <= 3; id++ {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			workUntilDone(done, id, exited)
+		}()
+	}
+	close(done)
+	workers.Wait()
+	close(exited)
+	var exitedIds []int
+	for id := range exited {
+		exitedIds = append(exitedIds, id)
+	}
+	slices.Sort(exitedIds)
+	fmt.Printf("workers exited: %v\n", exitedIds)
+	// Output:
+	// workers exited: [1 2 3]
+
+	// a send on done would wake a single worker
+	// a receive on a closed channel never blocks
+	// and returns the zero value at once
+	// so closing broadcasts to every receiver
+	// now and later
+	trace()
+	_, open := <-done
+	_, stillOpen := <-done
+	fmt.Printf("open: %v, still open: %v\n", open, stillOpen)
+	// Output:
+	// open: false, still open: false
+
+	// loop of messages
+	// the range automatically breaks
+	// when the channel closes
+	// with -deterministic the sender and the receiver
+	// take turns printing instead of racing
+	trace()
+	turns := newTurns()
+	sender = func() {
+		for i := 0; i < 5; i++ {
+			turns.take(2*i, func() { narrate("sending value %v\n", i) })
+			channel <- i
+		}
+		close(channel)
+	}
+
+	receiver = func() {
+		for value := range channel {
+			turns.take(2*value+1, func() { fmt.Printf("received value %v\n", value) })
+		}
+		fmt.Println("channel was closed")
+	}
+
+	runConcurrently(sender, receiver)
+	channel = make(chan int)
+	// Output:
+	// sending value 0
+	// received value 0
+	// sending value 1
+	// received value 1
+	// sending value 2
+	// received value 2
+	// sending value 3
+	// received value 3
+	// sending value 4
+	// received value 4
+	// channel was closed
+
+	// looping concurrently
+	// and receiving the results
+	trace()
+	workItems := []int{1, 2, 3, 4}
+
+	// each iteration has its own workItem
+	// so goroutines can use it directly
+	// before go 1.22 it had to be passed as an argument
+	// see the loopvar lesson
+	turns = newTurns()
+	for i, workItem := range workItems {
+		go func() {
+			turns.take(2*i, func() { narrate("sending result %v\n", workItem) })
+			channel <- workItem
+		}()
+	}
+
+	for i := range workItems {
+		result := <-channel
+		turns.take(2*i+1, func() { fmt.Printf("received result %v\n", result) })
+	}
+
+	close(channel)
+	channel = make(chan int)
+	// Output:
+	// sending result 1
+	// received result 1
+	// sending result 2
+	// received result 2
+	// sending result 3
+	// received result 3
+	// sending result 4
+	// received result 4
+
+	// controlling concurrency
+	// with a fixed number of receivers
+	// the workerpools lesson makes it reusable
+	trace()
+	sender = func() {
+		for i := 0; i < 5; i++ {
+			channel <- i
+		}
+		close(channel)
+	}
+
+	// with -deterministic the receivers
+	// take turns receiving the values
+	trace()
+	turns = newTurns()
+	indexedReceiver := func(index int) {
+		for turn := index - 1; ; turn += 2 {
+			received := true
+			turns.take(turn, func() {
+				var value int
+				if value, received = <-channel; received {
+					fmt.Printf("%v received value %v\n", index, value)
+				}
+			})
+			if !received {
+				return
+			}
+		}
+	}
+
+	runConcurrently(sender, func() { indexedReceiver(1) }, func() { indexedReceiver(2) })
+	// Output:
+	// 1 received value 0
+	// 2 received value 1
+	// 1 received value 2
+	// 2 received value 3
+	// 1 received value 4
+}
+
+func loopvarLesson() {
+
+	// before go 1.22 a loop declared its variable once
+	// every closure shared it and saw its last value
+	// declaring it outside the loop shows the old behavior
+	trace()
+	var printers []func()
+	var shared int
+	for shared = 0; shared < 3; shared++ {
+		printers = append(printers, func() { fmt.Printf("shared: %v\n", shared) })
+	}
+	for _, printer := range printers {
+		printer()
+	}
+	// Output:
+	// shared: 3
+	// shared: 3
+	// shared: 3
+
+	// since go 1.22 every iteration has its own variable
+	// which each closure captures
+	trace()
+	printers = nil
+	for i := 0; i < 3; i++ {
+		printers = append(printers, func() { fmt.Printf("per iteration: %v\n", i) })
+	}
+	for _, printer := range printers {
+		printer()
+	}
+	// Output:
+	// per iteration: 0
+	// per iteration: 1
+	// per iteration: 2
+
+	// goroutines can use the loop variable directly
+	// the i := i copy or passing it as an argument
+	// are no longer required
+	// the go version of the go.mod file decides
+	// which behavior applies, not the compiler
+	trace()
+	var wg sync.WaitGroup
+	results := make([]int, 3)
+	for i := range 3 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = i * 10
+		}()
+	}
+	wg.Wait()
+	fmt.Printf("results: %v\n", results)
+	// Output:
+	// results: [0 10 20]
+}
+
+// adding a default branch to a send
+// drops the message when the buffer is full
+// instead of blocking the sender
+// the basis for lossy pipelines like metrics
+// where a slow consumer must not stall the producer
+func trySend(channel chan int, value int) bool {
+	select {
+	case channel <- value:
+		return true
+	default:
+		fmt.Println("dropped")
+		return false
+	}
+}
+
+// racing a receive against time.After
+// bounds how long the caller waits
+// whichever is ready first wins
+func receiveWithin(channel <-chan int, limit time.Duration) (int, bool) {
+	select {
+	case value := <-channel:
+		return value, true
+	case <-time.After(limit):
+		return 0, false
+	}
+}
+
+func selectLesson() {
+
+	// selecting from multiple channels
+	// blocks until one of them receives a message
+	trace()
+	channel1 := make(chan int)
+	channel2 := make(chan int)
+
+	sender := func() {
+		channel2 <- 1
+	}
+
+	receiver := func() {
+		select {
+		case value := <-channel1:
+			fmt.Printf("received %v on channel1\n", value)
+		case value := <-channel2:
+			fmt.Printf("received %v on channel2\n", value)
+		}
+	}
+
+	runConcurrently(sender, receiver)
+	// Output:
+	// received 1 on channel2
+
+	// adding a default branch
+	// makes select non blocking
+	trace()
+	receiver = func() {
+		select {
+		case <-channel1:
+		default:
+			fmt.Println("received nothing")
+		}
+	}
+
+	runConcurrently(receiver)
+	close(channel1)
+	close(channel2)
+	// Output:
+	// received nothing
+
+	// bounding a wait with a timeout
+	// the buffers let the late sender finish
+	trace()
+	for _, delay := range []time.Duration{10 * time.Millisecond, 300 * time.Millisecond} {
+		delivery := make(chan int, 1)
+		go func() {
+			time.Sleep(delay)
+			delivery <- 1
+		}()
+		if value, ok := receiveWithin(delivery, 200*time.Millisecond); ok {
+			fmt.Printf("value %v won after %v\n", value, delay)
+		} else {
+			fmt.Printf("timeout won against %v\n", delay)
+		}
+	}
+	// Output:
+	// value 1 won after 10ms
+	// timeout won against 300ms
+
+	// channel types can be used to
+	// enforce the message directions
+	trace()
+	channel := make(chan int)
+	var _ chan<- int = channel
+	var _ <-chan int = channel
+
+	// a buffer size can be set on the channel
+	// the sender blocks only when the buffer is full
+	trace()
+	channel = make(chan int, 2)
+	close(channel)
+
+	// a full buffer makes
+	// the non blocking send drop
+	trace()
+	metrics := make(chan int, 1)
+	trySend(metrics, 1)
+	detail("metrics buffer holds %v of %v\n", len(metrics), cap(metrics))
+	trySend(metrics, 2)
+	fmt.Printf("received metric %v\n", <-metrics)
+	close(metrics)
+	// Output:
+	// dropped
+	// received metric 1
+}
+
+// each stage of a pipeline runs in its own goroutine
+// and selects on ctx.Done() next to every send
+// without it a stage blocked sending to a consumer
+// that went away would leak forever
+func generateNumbers(ctx context.Context, wg *sync.WaitGroup) <-chan int {
+	out := make(chan int)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer close(out)
+		for i := 1; ; i++ {
+			select {
+			case out <- i:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+func squareNumbers(ctx context.Context, wg *sync.WaitGroup, in <-chan int) <-chan int {
+	out := make(chan int)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer close(out)
+		for value := range in {
+			select {
+			case out <- value * value:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// a filter stage forwards only some values
+// it still selects on ctx.Done() for each send
+func filterNumbers(ctx context.Context, wg *sync.WaitGroup, in <-chan int, keep func(int) bool) <-chan int {
+	out := make(chan int)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer close(out)
+		for value := range in {
+			if !keep(value) {
+				continue
+			}
+			select {
+			case out <- value:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+func pipelinesLesson() {
+
+	// cancelling the context passed to
+	// every stage tears down the whole pipeline
+	trace()
+	pipelineContext, cancelPipeline := context.WithCancel(context.Background())
+	var pipelineGroup sync.WaitGroup
+	squares := squareNumbers(pipelineContext, &pipelineGroup, generateNumbers(pipelineContext, &pipelineGroup))
+	fmt.Printf("received square %v\n", <-squares)
+	fmt.Printf("received square %v\n", <-squares)
+	cancelPipeline()
+	pipelineGroup.Wait()
+	fmt.Println("pipeline stopped")
+	// Output:
+	// received square 1
+	// received square 4
+	// pipeline stopped
+
+	// stages compose by passing channels along
+	// generator, then transform, then filter
+	// the consumer stops after three values
+	// and the cancel travels back upstream
+	trace()
+	stagesContext, cancelStages := context.WithCancel(context.Background())
+	var stagesGroup sync.WaitGroup
+	numbers := generateNumbers(stagesContext, &stagesGroup)
+	squared := squareNumbers(stagesContext, &stagesGroup, numbers)
+	odd := filterNumbers(stagesContext, &stagesGroup, squared, func(value int) bool { return value%2 == 1 })
+	for range 3 {
+		fmt.Printf("received odd square %v\n", <-odd)
+	}
+	cancelStages()
+	stagesGroup.Wait()
+	fmt.Println("every stage stopped")
+	// Output:
+	// received odd square 1
+	// received odd square 9
+	// received odd square 25
+	// every stage stopped
+}
+
+type Resource struct {
+	Name string
+}
+
+var openResource = func() (*Resource, error) {
+	return &Resource{"database"}, nil
+}
+
+// sync.Once never runs its function twice
+// so a failed initialization is not retried
+// the error must be cached along with the result
+// or later callers would get a nil resource and no error
+var (
+	resourceOnce sync.Once
+	resource     *Resource
+	resourceErr  error
+)
+
+func getResource() (*Resource, error) {
+	resourceOnce.Do(func() {
+		resource, resourceErr = openResource()
+	})
+	return resource, resourceErr
+}
+
+// retrying a failed initialization
+// is done with a mutex instead
+// only a successful result is cached
+var (
+	retriedResourceMutex sync.Mutex
+	retriedResource      *Resource
+)
+
+func getResourceWithRetry() (*Resource, error) {
+	retriedResourceMutex.Lock()
+	defer retriedResourceMutex.Unlock()
+	if retriedResource != nil {
+		return retriedResource, nil
+	}
+	opened, err := openResource()
+	if err != nil {
+		return nil, err
+	}
+	retriedResource = opened
+	return retriedResource, nil
+}
+
+// a plain map guarded by a mutex
+// the read and the write of deposit
+// happen under the same lock
+type ledger struct {
+	mutex    sync.Mutex
+	balances map[string]int
+}
+
+func newLedger() *ledger {
+	return &ledger{balances: map[string]int{}}
+}
+
+func (l *ledger) deposit(account string, amount int) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.balances[account] += amount
+}
+
+func (l *ledger) balance(account string) int {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return l.balances[account]
+}
+
+func syncLesson() {
+
+	// a mutex allows one goroutine at a time
+	// must be used to protect shared state
+	trace()
+	var balanceMutex sync.Mutex
+	balance := 100
+
+	deposit := func(amount int) {
+		balanceMutex.Lock()
+		defer balanceMutex.Unlock()
+		balance += amount
+	}
+
+	runConcurrently(func() { deposit(15) }, func() { deposit(500) })
+
+	balanceMutex.Lock()
+	detail("balance is now %v\n", balance)
+	balanceMutex.Unlock()
+
+	// sync.Map is safe for concurrent use without a mutex
+	// LoadOrStore keeps the first value stored
+	// and tells the others which one won
+	trace()
+	var openedAccounts sync.Map
+	loadedResults := make(chan bool, 2)
+	openAccount := func(owner string) {
+		_, loaded := openedAccounts.LoadOrStore("joint", owner)
+		loadedResults <- loaded
+	}
+	runConcurrently(func() { openAccount("alice") }, func() { openAccount("bob") })
+	close(loadedResults)
+	stored := 0
+	for loaded := range loadedResults {
+		if !loaded {
+			stored++
+		}
+	}
+	jointOwner, _ := openedAccounts.Load("joint")
+	fmt.Printf("stored: %v, owner found: %v\n", stored, jointOwner != nil)
+	// Output:
+	// stored: 1, owner found: true
+
+	// Range visits the entries in no particular order
+	// the values are interface{} and need assertions
+	trace()
+	var accountBalances sync.Map
+	runConcurrently(
+		func() { accountBalances.Store("alice", 115) },
+		func() { accountBalances.Store("bob", 500) },
+	)
+	var lines []string
+	accountBalances.Range(func(key, value any) bool {
+		lines = append(lines, fmt.Sprintf("%v: %v", key, value.(int)))
+		return true
+	})
+	slices.Sort(lines)
+	fmt.Println(strings.Join(lines, ", "))
+	// Output:
+	// alice: 115, bob: 500
+
+	// sync.Map suits keys written once and read often
+	// or goroutines working on disjoint keys
+	// otherwise a plain map and a mutex is still preferable
+	// it is typed and a read then write stays atomic
+	trace()
+	ledger := newLedger()
+	runConcurrently(func() { ledger.deposit("alice", 15) }, func() { ledger.deposit("alice", 100) })
+	fmt.Printf("alice: %v\n", ledger.balance("alice"))
+	// Output:
+	// alice: 115
+
+	// a read-write mutex allows
+	// one writer or multiple readers
+	trace()
+	var readWriteMutex sync.RWMutex
+	coins := 0
+
+	moreCoins := func(count int) {
+		readWriteMutex.Lock()
+		defer readWriteMutex.Unlock()
+		coins += count
+	}
+
+	howManyCoins := func() int {
+		readWriteMutex.RLock()
+		defer readWriteMutex.RUnlock()
+		return coins
+	}
+
+	runConcurrently(func() { moreCoins(15) }, func() { howManyCoins() }, func() { howManyCoins() })
+
+	// a read-write mutex
+	// for the lazy initialization
+	// of a read-only state is provided
+	trace()
+	var onceMutex sync.Once
+	var lazyInitializedValue int
+
+	getLazyInitializedValue := func() int {
+		onceMutex.Do(func() { lazyInitializedValue = 10 + 2/7 - 16 })
+		return lazyInitializedValue
+	}
+
+	runConcurrently(func() { getLazyInitializedValue() }, func() { getLazyInitializedValue() })
+
+	// lazy initialization of a resource
+	// that can fail caches the error too
+	trace()
+	if lazyResource, err := getResource(); err == nil {
+		fmt.Printf("lazy resource %v\n", lazyResource.Name)
+	}
+	if retriedResource, err := getResourceWithRetry(); err == nil {
+		fmt.Printf("retried resource %v\n", retriedResource.Name)
+	}
+	// Output:
+	// lazy resource database
+	// retried resource database
+
+	// running a program with the race detector
+	// go run -race
+}
@@ -0,0 +1,194 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestTrySendDropsWhenFull(t *testing.T) {
+	channel := make(chan int, 1)
+	var sent, dropped bool
+	output, err := captureOutput(func() {
+		sent = trySend(channel, 1)
+		dropped = !trySend(channel, 2)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !sent {
+		t.Error("trySend dropped a value while the buffer had room")
+	}
+	if !dropped || output != "dropped\n" {
+		t.Errorf("trySend printed %q while the buffer was full, want it dropped", output)
+	}
+	if value := <-channel; value != 1 {
+		t.Errorf("received %v, want 1", value)
+	}
+}
+
+func TestWorkUntilDoneStopsOnClose(t *testing.T) {
+	done := make(chan struct{})
+	exited := make(chan int, 1)
+	go workUntilDone(done, 7, exited)
+	close(done)
+	if id, ok := receiveWithin(exited, time.Second); id != 7 || !ok {
+		t.Errorf("workUntilDone exited with %v, %v, want 7, true", id, ok)
+	}
+}
+
+func TestReceiveWithin(t *testing.T) {
+	ready := make(chan int, 1)
+	ready <- 7
+	if value, ok := receiveWithin(ready, time.Second); value != 7 || !ok {
+		t.Errorf("receiveWithin(ready) = %v, %v, want 7, true", value, ok)
+	}
+	if value, ok := receiveWithin(make(chan int), 10*time.Millisecond); value != 0 || ok {
+		t.Errorf("receiveWithin(empty) = %v, %v, want 0, false", value, ok)
+	}
+}
+
+func TestPipelineStopsWhenCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	squares := squareNumbers(ctx, &wg, generateNumbers(ctx, &wg))
+
+	for _, want := range []int{1, 4} {
+		if got := <-squares; got != want {
+			t.Errorf("received %v, want %v", got, want)
+		}
+	}
+	cancel()
+
+	// without the ctx.Done() cases
+	// both goroutines would stay blocked
+	// on their sends and never exit
+	stopped := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		t.Fatal("pipeline goroutines did not exit after cancel")
+	}
+}
+
+func TestFilterNumbersKeepsMatches(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var wg sync.WaitGroup
+	even := filterNumbers(ctx, &wg, generateNumbers(ctx, &wg), func(value int) bool { return value%2 == 0 })
+
+	for _, want := range []int{2, 4, 6} {
+		if got := <-even; got != want {
+			t.Errorf("received %v, want %v", got, want)
+		}
+	}
+	cancel()
+	wg.Wait()
+}
+
+func TestLedgerDepositsConcurrently(t *testing.T) {
+	ledger := newLedger()
+	var wg sync.WaitGroup
+	for range 100 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ledger.deposit("alice", 1)
+		}()
+	}
+	wg.Wait()
+	if got := ledger.balance("alice"); got != 100 {
+		t.Errorf("balance after 100 deposits = %v, want 100", got)
+	}
+}
+
+func TestGetResourceInitializesOnce(t *testing.T) {
+	openResourceReal := openResource
+	defer func() {
+		openResource = openResourceReal
+		resourceOnce = sync.Once{}
+	}()
+	resourceOnce = sync.Once{}
+
+	var opened atomic.Int32
+	openResource = func() (*Resource, error) {
+		opened.Add(1)
+		return nil, fmt.Errorf("connection refused")
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := getResource(); err == nil {
+				t.Error("getResource did not return the cached error")
+			}
+		}()
+	}
+	wg.Wait()
+
+	if count := opened.Load(); count != 1 {
+		t.Errorf("resource opened %v times, want 1", count)
+	}
+}
+
+func TestGetResourceWithRetryRetriesFailures(t *testing.T) {
+	openResourceReal := openResource
+	defer func() {
+		openResource = openResourceReal
+		retriedResource = nil
+	}()
+	retriedResource = nil
+
+	var opened atomic.Int32
+	openResource = func() (*Resource, error) {
+		if opened.Add(1) == 1 {
+			return nil, fmt.Errorf("connection refused")
+		}
+		return &Resource{"database"}, nil
+	}
+
+	if _, err := getResourceWithRetry(); err == nil {
+		t.Error("first getResourceWithRetry did not fail")
+	}
+	for i := 0; i < 3; i++ {
+		if _, err := getResourceWithRetry(); err != nil {
+			t.Errorf("getResourceWithRetry failed after a retry: %v", err)
+		}
+	}
+	if count := opened.Load(); count != 2 {
+		t.Errorf("resource opened %v times, want 2", count)
+	}
+}
+
+func TestGoroutineDumpTrims(t *testing.T) {
+	dump := goroutineDump(false, 2)
+	lines := strings.Split(dump, "\n")
+	if len(lines) != 3 || !strings.HasPrefix(lines[0], "goroutine ") || lines[2] != "\t..." {
+		t.Errorf("goroutineDump(false, 2) = %q, want a header, a call and an ellipsis", dump)
+	}
+	if !strings.Contains(lines[1], "goroutineDump") {
+		t.Errorf("goroutineDump(false, 2) = %q, want goroutineDump on top", dump)
+	}
+}
+
+func TestRunConcurrentlyWaitsForAll(t *testing.T) {
+	var finished atomic.Int32
+	nap := func() {
+		time.Sleep(10 * time.Millisecond)
+		finished.Add(1)
+	}
+	runConcurrently(nap, nap, nap)
+	if got := finished.Load(); got != 3 {
+		t.Errorf("runConcurrently returned after %v of 3 functions finished", got)
+	}
+}
@@ -0,0 +1,133 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+)
+
+// a queue that blocks producers when full
+// and consumers when empty
+// both wait on a condition variable
+// sharing the mutex that guards the items
+type BlockingQueue[T any] struct {
+	mutex    sync.Mutex
+	notFull  *sync.Cond
+	notEmpty *sync.Cond
+	items    []T
+	capacity int
+	closed   bool
+}
+
+// a queue without room would block every Put forever
+func NewBlockingQueue[T any](capacity int) *BlockingQueue[T] {
+	if capacity < 1 {
+		panic(fmt.Sprintf("blocking queue capacity %v is below 1", capacity))
+	}
+	q := &BlockingQueue[T]{capacity: capacity}
+	q.notFull = sync.NewCond(&q.mutex)
+	q.notEmpty = sync.NewCond(&q.mutex)
+	return q
+}
+
+// Wait unlocks the mutex while it sleeps
+// and locks it again before returning
+// the condition must be checked again in a for loop
+// another goroutine may have taken the room first
+// and wake ups can happen without a Signal
+// Put returns false once the queue is closed
+// the value is then dropped
+func (q *BlockingQueue[T]) Put(value T) bool {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	for len(q.items) == q.capacity && !q.closed {
+		q.notFull.Wait()
+	}
+	if q.closed {
+		return false
+	}
+	q.items = append(q.items, value)
+
+	// Signal wakes a single waiting consumer
+	q.notEmpty.Signal()
+	return true
+}
+
+// Take returns false once the queue is closed
+// and every item was taken
+func (q *BlockingQueue[T]) Take() (T, bool) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	for len(q.items) == 0 && !q.closed {
+		q.notEmpty.Wait()
+	}
+	var zero T
+	if len(q.items) == 0 {
+		return zero, false
+	}
+	value := q.items[0]
+	q.items[0] = zero
+	q.items = q.items[1:]
+	q.notFull.Signal()
+	return value, true
+}
+
+// Broadcast wakes every waiting consumer and producer
+// so they all see the queue is closed
+func (q *BlockingQueue[T]) Close() {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	q.closed = true
+	q.notEmpty.Broadcast()
+	q.notFull.Broadcast()
+}
+
+func condLesson() {
+
+	// one producer and two consumers
+	// sharing a queue of two items
+	trace()
+	queue := NewBlockingQueue[int](2)
+	var consumed sync.Mutex
+	var received []int
+	consume := func() {
+		for {
+			value, ok := queue.Take()
+			if !ok {
+				return
+			}
+			consumed.Lock()
+			received = append(received, value)
+			consumed.Unlock()
+		}
+	}
+	produce := func() {
+		for i := 1; i <= 6; i++ {
+			queue.Put(i)
+		}
+		queue.Close()
+	}
+	runConcurrently(produce, consume, consume)
+	slices.Sort(received)
+	fmt.Printf("received: %v\n", received)
+	// Output:
+	// received: [1 2 3 4 5 6]
+
+	// Broadcast wakes the consumers
+	// still waiting on an empty queue
+	trace()
+	empty := NewBlockingQueue[string](1)
+	var finished sync.WaitGroup
+	for range 3 {
+		finished.Add(1)
+		go func() {
+			defer finished.Done()
+			empty.Take()
+		}()
+	}
+	empty.Close()
+	finished.Wait()
+	fmt.Println("every consumer woke up")
+	// Output:
+	// every consumer woke up
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBlockingQueueKeepsOrder(t *testing.T) {
+	queue := NewBlockingQueue[int](3)
+	for i := range 3 {
+		queue.Put(i)
+	}
+	queue.Close()
+	for want := range 3 {
+		if got, ok := queue.Take(); got != want || !ok {
+			t.Errorf("Take() = %v, %v, want %v, true", got, ok, want)
+		}
+	}
+	if got, ok := queue.Take(); ok {
+		t.Errorf("Take() on a closed empty queue = %v, true, want false", got)
+	}
+}
+
+func TestBlockingQueueBlocksWhenFull(t *testing.T) {
+	queue := NewBlockingQueue[int](1)
+	queue.Put(1)
+	put := make(chan bool)
+	go func() {
+		queue.Put(2)
+		put <- true
+	}()
+
+	select {
+	case <-put:
+		t.Fatal("Put() returned while the queue was full")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	queue.Take()
+	select {
+	case <-put:
+	case <-time.After(time.Second):
+		t.Fatal("Put() stayed blocked after a Take()")
+	}
+}
+
+func TestBlockingQueueRejectsPutAfterClose(t *testing.T) {
+	queue := NewBlockingQueue[int](1)
+	queue.Close()
+	if queue.Put(1) {
+		t.Error("Put() after Close() = true, want false")
+	}
+	if got, ok := queue.Take(); ok {
+		t.Errorf("Take() = %v, true, want the rejected value dropped", got)
+	}
+}
+
+func TestBlockingQueueCloseWakesBlockedProducer(t *testing.T) {
+	queue := NewBlockingQueue[int](1)
+	queue.Put(1)
+	put := make(chan bool)
+	go func() {
+		put <- queue.Put(2)
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	queue.Close()
+	select {
+	case added := <-put:
+		if added {
+			t.Error("blocked Put() = true after Close(), want false")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Close() did not wake the blocked Put()")
+	}
+}
+
+func TestNewBlockingQueueRejectsNoCapacity(t *testing.T) {
+	for _, capacity := range []int{0, -1} {
+		if recovered(func() { NewBlockingQueue[int](capacity) }) == nil {
+			t.Errorf("NewBlockingQueue(%v) did not panic", capacity)
+		}
+	}
+}
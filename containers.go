@@ -0,0 +1,130 @@
+package main
+
+import "fmt"
+
+// last in first out
+// the zero value is an empty stack
+type Stack[T any] struct {
+	values []T
+}
+
+func (s *Stack[T]) Push(value T) {
+	s.values = append(s.values, value)
+}
+
+// popping an empty stack
+// returns the zero value and false
+func (s *Stack[T]) Pop() (T, bool) {
+	var zero T
+	if len(s.values) == 0 {
+		return zero, false
+	}
+	value := s.values[len(s.values)-1]
+	s.values[len(s.values)-1] = zero
+	s.values = s.values[:len(s.values)-1]
+	return value, true
+}
+
+func (s *Stack[T]) Len() int {
+	return len(s.values)
+}
+
+// first in first out
+// the zero value is an empty queue
+type Queue[T any] struct {
+	values []T
+}
+
+func (q *Queue[T]) Push(value T) {
+	q.values = append(q.values, value)
+}
+
+// the popped slot is cleared
+// so the queue does not keep its value alive
+func (q *Queue[T]) Pop() (T, bool) {
+	var zero T
+	if len(q.values) == 0 {
+		return zero, false
+	}
+	value := q.values[0]
+	q.values[0] = zero
+	q.values = q.values[1:]
+	return value, true
+}
+
+func (q *Queue[T]) Len() int {
+	return len(q.values)
+}
+
+// a set is a map of empty structures
+// which take no memory
+// keys must be comparable
+type Set[T comparable] struct {
+	values map[T]struct{}
+}
+
+func NewSet[T comparable](values ...T) *Set[T] {
+	set := &Set[T]{values: make(map[T]struct{})}
+	for _, value := range values {
+		set.Add(value)
+	}
+	return set
+}
+
+func (s *Set[T]) Add(value T) {
+	s.values[value] = struct{}{}
+}
+
+func (s *Set[T]) Has(value T) bool {
+	_, ok := s.values[value]
+	return ok
+}
+
+func (s *Set[T]) Remove(value T) {
+	delete(s.values, value)
+}
+
+func (s *Set[T]) Len() int {
+	return len(s.values)
+}
+
+func containersLesson() {
+
+	// a stack of strings
+	// pops the last pushed value first
+	trace()
+	var stack Stack[string]
+	stack.Push("first")
+	stack.Push("second")
+	top, _ := stack.Pop()
+	fmt.Printf("popped: %v, left: %v\n", top, stack.Len())
+	// Output:
+	// popped: second, left: 1
+
+	// a queue of ints
+	// pops the first pushed value first
+	trace()
+	var queue Queue[int]
+	queue.Push(1)
+	queue.Push(2)
+	head, _ := queue.Pop()
+	fmt.Printf("popped: %v, left: %v\n", head, queue.Len())
+	// Output:
+	// popped: 1, left: 1
+
+	// popping an empty container
+	// returns false instead of panicking
+	trace()
+	queue.Pop()
+	_, ok := queue.Pop()
+	fmt.Printf("popped from empty queue: %v\n", ok)
+	// Output:
+	// popped from empty queue: false
+
+	// a set ignores duplicates
+	trace()
+	set := NewSet("go", "rust", "go")
+	fmt.Printf("len: %v, has go: %v, has zig: %v\n", set.Len(), set.Has("go"), set.Has("zig"))
+	// Output:
+	// len: 2, has go: true, has zig: false
+}
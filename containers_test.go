@@ -0,0 +1,76 @@
+package main
+
+import "testing"
+
+func TestStack(t *testing.T) {
+	var tests = []struct {
+		pushed []int
+		popped []int
+	}{
+		{nil, nil},
+		{[]int{1}, []int{1}},
+		{[]int{1, 2, 3}, []int{3, 2, 1}},
+	}
+	for _, test := range tests {
+		var stack Stack[int]
+		for _, value := range test.pushed {
+			stack.Push(value)
+		}
+		for _, want := range test.popped {
+			if got, ok := stack.Pop(); got != want || !ok {
+				t.Errorf("Pop() after pushing %v = %v, %v, want %v, true", test.pushed, got, ok, want)
+			}
+		}
+		if _, ok := stack.Pop(); ok || stack.Len() != 0 {
+			t.Errorf("stack of %v not empty after popping everything", test.pushed)
+		}
+	}
+}
+
+func TestQueue(t *testing.T) {
+	var tests = []struct {
+		pushed []string
+		popped []string
+	}{
+		{nil, nil},
+		{[]string{"a"}, []string{"a"}},
+		{[]string{"a", "b", "c"}, []string{"a", "b", "c"}},
+	}
+	for _, test := range tests {
+		var queue Queue[string]
+		for _, value := range test.pushed {
+			queue.Push(value)
+		}
+		for _, want := range test.popped {
+			if got, ok := queue.Pop(); got != want || !ok {
+				t.Errorf("Pop() after pushing %v = %v, %v, want %v, true", test.pushed, got, ok, want)
+			}
+		}
+		if _, ok := queue.Pop(); ok || queue.Len() != 0 {
+			t.Errorf("queue of %v not empty after popping everything", test.pushed)
+		}
+	}
+}
+
+func TestSet(t *testing.T) {
+	set := NewSet(1, 2, 2, 3)
+	set.Remove(3)
+
+	var tests = []struct {
+		value int
+		want  bool
+	}{
+		{1, true},
+		{2, true},
+		{3, false},
+		{4, false},
+	}
+	for _, test := range tests {
+		if got := set.Has(test.value); got != test.want {
+			t.Errorf("Has(%v) = %v, want %v", test.value, got, test.want)
+		}
+	}
+	if set.Len() != 2 {
+		t.Errorf("Len() = %v, want 2", set.Len())
+	}
+}
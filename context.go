@@ -0,0 +1,360 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+)
+
+func init() {
+	RegisterChapter(Chapter{12, "Context", []Lesson{
+		{"cancellation", "cancelling work with context.Context", cancellationLesson, []string{"context", "context.Context", "WithCancel", "cancel", "ctx.Done", "ctx.Err", "context.Canceled", "WithCancelCause"}, []string{"select", "goroutines"}, []string{"intermediate", "context", "concurrency"}},
+		{"timeouts", "timeouts and deadlines with context", timeoutsLesson, []string{"WithTimeout", "WithDeadline", "Deadline", "context.DeadlineExceeded", "context.Canceled", "timeout"}, []string{"cancellation", "timers"}, []string{"intermediate", "context", "concurrency"}},
+		{"values", "request scoped values with context", valuesLesson, []string{"WithValue", "ctx.Value", "context key", "request id", "type assertion"}, []string{"cancellation", "assertions"}, []string{"intermediate", "context"}},
+		{"errgroups", "running tasks with errgroup", errgroupsLesson, []string{"errgroup", "golang.org/x/sync", "errgroup.WithContext", "g.Go", "g.Wait", "SetLimit", "first error"}, []string{"cancellation", "waitgroups"}, []string{"advanced", "context", "concurrency"}},
+	}})
+}
+
+// a context is the first parameter by convention
+// named ctx and never stored in a struct
+// the work stops as soon as ctx.Done() is closed
+// and returns ctx.Err() to say why
+func bakeBatches(ctx context.Context, batches int, baked chan<- int) error {
+	for batch := 1; batch <= batches; batch++ {
+		select {
+		case baked <- batch:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// a lookup that takes delay to answer
+// unless its context is done first
+func slowLookup(ctx context.Context, delay time.Duration) (string, error) {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return "found", nil
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+}
+
+// the two errors a done context can return
+// DeadlineExceeded when the time ran out
+// Canceled when someone called cancel
+func whyStopped(err error) string {
+	switch {
+	case err == nil:
+		return "finished"
+	case errors.Is(err, context.DeadlineExceeded):
+		return "took too long"
+	case errors.Is(err, context.Canceled):
+		return "cancelled by the caller"
+	default:
+		return "failed"
+	}
+}
+
+// an unexported key type
+// cannot collide with the keys of any other package
+// even one using the same underlying value
+type requestIDKey struct{}
+
+func withRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// Value returns an interface{}
+// the assertion reports whether the id was set
+func requestID(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(requestIDKey{}).(string)
+	return id, ok
+}
+
+// values are for request scoped metadata
+// like ids and credentials that cross api boundaries
+// never for optional parameters
+// those belong in the signature where the compiler sees them
+func handleOrder(ctx context.Context) string {
+	return chargeCard(ctx, 12)
+}
+
+func chargeCard(ctx context.Context, amount int) string {
+	return logCharge(ctx, amount)
+}
+
+func logCharge(ctx context.Context, amount int) string {
+	id, ok := requestID(ctx)
+	if !ok {
+		id = "unknown"
+	}
+	return fmt.Sprintf("request %v: charged %v", id, amount)
+}
+
+type supplier struct {
+	name  string
+	delay time.Duration
+}
+
+// a supplier answers after its delay
+// flour is always out of stock
+// a done context stops the wait early
+func checkSupplier(ctx context.Context, s supplier) error {
+	timer := time.NewTimer(s.delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		if s.name == "flour" {
+			return fmt.Errorf("%v: out of stock", s.name)
+		}
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("%v: %w", s.name, ctx.Err())
+	}
+}
+
+// without errgroup
+// a WaitGroup waits for the tasks
+// a buffered channel collects their errors
+// nothing stops the others after the first failure
+func checkSuppliersManually(suppliers []supplier) error {
+	var wg sync.WaitGroup
+	errs := make(chan error, len(suppliers))
+	for _, s := range suppliers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := checkSupplier(context.Background(), s); err != nil {
+				errs <- err
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	return <-errs
+}
+
+// errgroup does the same bookkeeping
+// Wait returns the first error
+// and the derived context is cancelled as soon as it happens
+func checkSuppliers(ctx context.Context, suppliers []supplier) error {
+	group, groupContext := errgroup.WithContext(ctx)
+	for _, s := range suppliers {
+		group.Go(func() error {
+			return checkSupplier(groupContext, s)
+		})
+	}
+	return group.Wait()
+}
+
+func cancellationLesson() {
+
+	// Background is the root of every context
+	// WithCancel derives one that can be cancelled
+	// cancel must always be called to release it
+	trace()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	fmt.Printf("before cancel: %v\n", ctx.Err())
+	cancel()
+	<-ctx.Done()
+	fmt.Printf("after cancel: %v\n", ctx.Err())
+	fmt.Printf("is canceled: %v\n", errors.Is(ctx.Err(), context.Canceled))
+	// Output:
+	// before cancel: <nil>
+	// after cancel: context canceled
+	// is canceled: true
+
+	// a goroutine selecting on ctx.Done()
+	// stops when the consumer has had enough
+	trace()
+	ovenContext, stopOven := context.WithCancel(context.Background())
+	baked := make(chan int)
+	result := make(chan error)
+	go func() {
+		result <- bakeBatches(ovenContext, 10, baked)
+	}()
+	fmt.Printf("received batch %v\n", <-baked)
+	fmt.Printf("received batch %v\n", <-baked)
+	stopOven()
+	fmt.Printf("baking stopped: %v\n", <-result)
+	// Output:
+	// received batch 1
+	// received batch 2
+	// baking stopped: context canceled
+
+	// cancelling a parent cancels its children
+	// but not the other way around
+	trace()
+	parent, cancelParent := context.WithCancel(context.Background())
+	child, cancelChild := context.WithCancel(parent)
+	cancelChild()
+	fmt.Printf("parent: %v, child: %v\n", parent.Err(), child.Err())
+	sibling, cancelSibling := context.WithCancel(parent)
+	defer cancelSibling()
+	cancelParent()
+	fmt.Printf("parent: %v, sibling: %v\n", parent.Err(), sibling.Err())
+	// Output:
+	// parent: <nil>, child: context canceled
+	// parent: context canceled, sibling: context canceled
+
+	// WithCancelCause records why it was cancelled
+	// ctx.Err() stays context.Canceled
+	trace()
+	causeContext, cancelWithCause := context.WithCancelCause(context.Background())
+	cancelWithCause(errors.New("out of flour"))
+	fmt.Printf("err: %v, cause: %v\n", causeContext.Err(), context.Cause(causeContext))
+	// Output:
+	// err: context canceled, cause: out of flour
+}
+
+func timeoutsLesson() {
+
+	// WithTimeout cancels the context by itself
+	// once the duration has passed
+	// cancel is still called to release it early
+	trace()
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	value, err := slowLookup(ctx, 200*time.Millisecond)
+	fmt.Printf("value: %q, err: %v\n", value, err)
+	// Output:
+	// value: "", err: context deadline exceeded
+
+	// a fast enough lookup is not affected
+	trace()
+	quickContext, cancelQuick := context.WithTimeout(context.Background(), time.Second)
+	defer cancelQuick()
+	value, err = slowLookup(quickContext, time.Millisecond)
+	fmt.Printf("value: %q, err: %v\n", value, err)
+	// Output:
+	// value: "found", err: <nil>
+
+	// WithDeadline takes a point in time instead
+	// Deadline reports it
+	// Background has none
+	trace()
+	deadline := time.Now().Add(time.Hour)
+	deadlineContext, cancelDeadline := context.WithDeadline(context.Background(), deadline)
+	defer cancelDeadline()
+	when, ok := deadlineContext.Deadline()
+	fmt.Printf("deadline set: %v, matches: %v\n", ok, when.Equal(deadline))
+	_, ok = context.Background().Deadline()
+	fmt.Printf("background deadline set: %v\n", ok)
+	// Output:
+	// deadline set: true, matches: true
+	// background deadline set: false
+
+	// a child cannot extend the deadline of its parent
+	trace()
+	longer, cancelLonger := context.WithTimeout(deadlineContext, 2*time.Hour)
+	defer cancelLonger()
+	childDeadline, _ := longer.Deadline()
+	fmt.Printf("child keeps the parent deadline: %v\n", childDeadline.Equal(deadline))
+	// Output:
+	// child keeps the parent deadline: true
+
+	// telling a timeout from a cancellation
+	trace()
+	cancelled, cancelNow := context.WithTimeout(context.Background(), time.Hour)
+	cancelNow()
+	_, cancelledErr := slowLookup(cancelled, time.Hour)
+	fmt.Printf("quick: %v\n", whyStopped(err))
+	fmt.Printf("slow: %v\n", whyStopped(ctx.Err()))
+	fmt.Printf("cancelled: %v\n", whyStopped(cancelledErr))
+	// Output:
+	// quick: finished
+	// slow: took too long
+	// cancelled: cancelled by the caller
+}
+
+func valuesLesson() {
+
+	// a value attached at the top of the call chain
+	// is read three calls deeper
+	// without threading it through every signature
+	trace()
+	ctx := withRequestID(context.Background(), "r-42")
+	fmt.Println(handleOrder(ctx))
+	fmt.Println(handleOrder(context.Background()))
+	// Output:
+	// request r-42: charged 12
+	// request unknown: charged 12
+
+	// derived contexts keep the values of their parent
+	trace()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	id, ok := requestID(ctx)
+	fmt.Printf("id: %v, found: %v\n", id, ok)
+	// Output:
+	// id: r-42, found: true
+
+	// string keys are an anti-pattern
+	// two packages choosing "id" overwrite each other
+	// the lookup finds the closest one only
+	trace()
+	shared := context.WithValue(context.Background(), "id", "session 7")
+	shared = context.WithValue(shared, "id", 99)
+	fmt.Printf("id: %v\n", shared.Value("id"))
+	// Output:
+	// id: 99
+
+	// a key of its own type never matches a string
+	trace()
+	typed := withRequestID(shared, "r-43")
+	id, _ = requestID(typed)
+	fmt.Printf("request id: %v, string id: %v\n", id, typed.Value("id"))
+	// Output:
+	// request id: r-43, string id: 99
+}
+
+func errgroupsLesson() {
+
+	suppliers := []supplier{
+		{"eggs", 5 * time.Millisecond},
+		{"flour", 10 * time.Millisecond},
+		{"sugar", 200 * time.Millisecond},
+	}
+
+	// the manual version waits for sugar
+	// even though flour already failed
+	trace()
+	start := time.Now()
+	err := checkSuppliersManually(suppliers)
+	fmt.Printf("err: %v, waited for sugar: %v\n", err, time.Since(start) >= 200*time.Millisecond)
+	// Output:
+	// err: flour: out of stock, waited for sugar: true
+
+	// errgroup cancels sugar when flour fails
+	trace()
+	start = time.Now()
+	err = checkSuppliers(context.Background(), suppliers)
+	fmt.Printf("err: %v, waited for sugar: %v\n", err, time.Since(start) >= 200*time.Millisecond)
+	// Output:
+	// err: flour: out of stock, waited for sugar: false
+
+	// SetLimit bounds how many tasks run at once
+	// Go blocks until one of them returns
+	// TryGo returns false instead
+	trace()
+	var group errgroup.Group
+	group.SetLimit(1)
+	group.Go(func() error {
+		time.Sleep(10 * time.Millisecond)
+		return nil
+	})
+	fmt.Printf("started a second task: %v\n", group.TryGo(func() error { return nil }))
+	fmt.Printf("err: %v\n", group.Wait())
+	// Output:
+	// started a second task: false
+	// err: <nil>
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestBakeBatchesCompletes(t *testing.T) {
+	baked := make(chan int, 3)
+	if err := bakeBatches(context.Background(), 3, baked); err != nil {
+		t.Errorf("bakeBatches() = %v, want nil", err)
+	}
+	if len(baked) != 3 {
+		t.Errorf("bakeBatches() sent %v batches, want 3", len(baked))
+	}
+}
+
+func TestBakeBatchesStopsWhenCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := bakeBatches(ctx, 3, make(chan int)); !errors.Is(err, context.Canceled) {
+		t.Errorf("bakeBatches() = %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestSlowLookupTimesOut(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+	if _, err := slowLookup(ctx, time.Hour); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("slowLookup() = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestWhyStopped(t *testing.T) {
+	tests := []struct {
+		err  error
+		want string
+	}{
+		{nil, "finished"},
+		{context.DeadlineExceeded, "took too long"},
+		{fmt.Errorf("lookup: %w", context.Canceled), "cancelled by the caller"},
+		{errors.New("burnt"), "failed"},
+	}
+	for _, test := range tests {
+		if got := whyStopped(test.err); got != test.want {
+			t.Errorf("whyStopped(%v) = %v, want %v", test.err, got, test.want)
+		}
+	}
+}
+
+func TestRequestIDFollowsTheCallChain(t *testing.T) {
+	tests := []struct {
+		ctx  context.Context
+		want string
+	}{
+		{withRequestID(context.Background(), "r-1"), "request r-1: charged 12"},
+		{context.WithValue(context.Background(), "id", "r-2"), "request unknown: charged 12"},
+	}
+	for _, test := range tests {
+		if got := handleOrder(test.ctx); got != test.want {
+			t.Errorf("handleOrder() = %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestCheckSuppliersCancelsTheRest(t *testing.T) {
+	suppliers := []supplier{
+		{"flour", time.Millisecond},
+		{"sugar", time.Hour},
+	}
+	done := make(chan error)
+	go func() {
+		done <- checkSuppliers(context.Background(), suppliers)
+	}()
+	select {
+	case err := <-done:
+		if err == nil || err.Error() != "flour: out of stock" {
+			t.Errorf("checkSuppliers() = %v, want flour: out of stock", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("checkSuppliers() kept waiting for sugar")
+	}
+}
+
+func TestCheckSuppliersManuallyReturnsTheError(t *testing.T) {
+	suppliers := []supplier{
+		{"eggs", time.Millisecond},
+		{"flour", time.Millisecond},
+	}
+	if err := checkSuppliersManually(suppliers); err == nil || err.Error() != "flour: out of stock" {
+		t.Errorf("checkSuppliersManually() = %v, want flour: out of stock", err)
+	}
+}
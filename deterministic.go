@@ -0,0 +1,48 @@
+package main
+
+import "sync"
+
+// stabilizing the output of the concurrency lessons
+// go run . -deterministic
+// goroutines print in a fixed order
+// by taking turns instead of racing
+// the default is free running
+// to show the real nondeterminism
+var deterministic = false
+
+// turns are numbered from zero
+// and taken one after the other
+type turns struct {
+	mutex sync.Mutex
+	cond  *sync.Cond
+	next  int
+}
+
+func newTurns() *turns {
+	t := &turns{}
+	t.cond = sync.NewCond(&t.mutex)
+	return t
+}
+
+// waiting for a turn then running f
+// before passing the turn to the next one
+// f runs right away when not deterministic
+func (t *turns) take(turn int, f func()) {
+	if !deterministic {
+		f()
+		return
+	}
+
+	t.mutex.Lock()
+	for t.next != turn {
+		t.cond.Wait()
+	}
+	t.mutex.Unlock()
+
+	f()
+
+	t.mutex.Lock()
+	t.next++
+	t.cond.Broadcast()
+	t.mutex.Unlock()
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func TestTurns(t *testing.T) {
+	deterministicReal := deterministic
+	defer func() { deterministic = deterministicReal }()
+	deterministic = true
+
+	// started in reverse
+	// but taking turns in order
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	taken := ""
+	turns := newTurns()
+	for i := 4; i >= 0; i-- {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			turns.take(i, func() {
+				mutex.Lock()
+				taken += fmt.Sprint(i)
+				mutex.Unlock()
+			})
+		}()
+	}
+	wg.Wait()
+
+	if taken != "01234" {
+		t.Errorf("turns taken %v, want 01234", taken)
+	}
+}
@@ -0,0 +1,7 @@
+//go:build demo
+
+package main
+
+// custom tags are set on the command line
+// go run -tags demo .
+const edition = "demo"
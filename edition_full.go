@@ -0,0 +1,5 @@
+//go:build !demo
+
+package main
+
+const edition = "full"
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+)
+
+// files are embedded into the binary at build time
+// the directive goes right above a package variable
+// paths are relative to the source file
+// and cannot reach outside its module
+
+// a single file as a string or a []byte
+//
+//go:embed assets/greeting.txt
+var embeddedGreeting string
+
+// a whole directory as a read only file system
+// files starting with . or _ are left out
+// unless the pattern starts with all:
+//
+//go:embed assets/site
+var embeddedSite embed.FS
+
+// the lesson server mounts the directory under /site/
+// go run . -serve :8080
+// fs.Sub strips the assets/site prefix
+// and StripPrefix the /site of the route
+// so the files are served from the root of the directory
+func siteHandler() http.Handler {
+	site, err := fs.Sub(embeddedSite, "assets/site")
+	if err != nil {
+		// only an invalid directory name fails
+		panic(err)
+	}
+	return http.StripPrefix("/site", http.FileServerFS(site))
+}
+
+func embedLesson() {
+
+	// the file content is in the variable
+	// no file is opened at run time
+	trace()
+	fmt.Print(embeddedGreeting)
+	// Output:
+	// hello from an embedded file
+
+	// walking the embedded directory
+	trace()
+	fs.WalkDir(embeddedSite, ".", func(path string, entry fs.DirEntry, err error) error {
+		if !entry.IsDir() {
+			fmt.Println(path)
+		}
+		return err
+	})
+	// Output:
+	// assets/site/index.html
+	// assets/site/style.css
+
+	// reading one of its files
+	trace()
+	style, err := embeddedSite.ReadFile("assets/site/style.css")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Print(string(style))
+	// Output:
+	// h1 { color: teal; }
+
+	// the lesson server serves the directory
+	// requests are handled in memory
+	// as they would be by go run . -serve
+	trace()
+	server := newLessonServer()
+	for _, path := range []string{"/site/", "/site/style.css", "/site/missing.css"} {
+		response := httptest.NewRecorder()
+		server.ServeHTTP(response, httptest.NewRequest("GET", path, nil))
+		fmt.Printf("GET %v: %v, %v bytes of %v\n", path, response.Code, response.Body.Len(), response.Header().Get("Content-Type"))
+	}
+	// Output:
+	// GET /site/: 200, 118 bytes of text/html; charset=utf-8
+	// GET /site/style.css: 200, 20 bytes of text/css; charset=utf-8
+	// GET /site/missing.css: 404, 19 bytes of text/plain; charset=utf-8
+}
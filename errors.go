@@ -0,0 +1,210 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+)
+
+// each layer adds its context
+// %w keeps the wrapped error reachable
+func openConfig(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("while opening the config: %w", err)
+	}
+	return file.Close()
+}
+
+func loadSettings(path string) error {
+	if err := openConfig(path); err != nil {
+		return fmt.Errorf("while loading the settings: %w", err)
+	}
+	return nil
+}
+
+func wrappingLesson() {
+
+	// the message reads like
+	// a chain of contexts
+	trace()
+	err := loadSettings("/missing/settings.json")
+	fmt.Println(err)
+	// Output:
+	// while loading the settings: while opening the config: open /missing/settings.json: no such file or directory
+
+	// errors.Is walks the chain
+	// looking for a sentinel error
+	trace()
+	fmt.Printf("does not exist: %v\n", errors.Is(err, fs.ErrNotExist))
+	fmt.Printf("permission denied: %v\n", errors.Is(err, fs.ErrPermission))
+	// Output:
+	// does not exist: true
+	// permission denied: false
+
+	// errors.As walks the chain
+	// looking for an error of a type
+	// and sets the target to it
+	trace()
+	var pathError *fs.PathError
+	if errors.As(err, &pathError) {
+		fmt.Printf("operation: %v, path: %v\n", pathError.Op, pathError.Path)
+	}
+	// Output:
+	// operation: open, path: /missing/settings.json
+
+	// errors.Unwrap removes one layer at a time
+	trace()
+	for layer := err; layer != nil; layer = errors.Unwrap(layer) {
+		fmt.Printf("%T\n", layer)
+	}
+	// Output:
+	// *fmt.wrapError
+	// *fmt.wrapError
+	// *fs.PathError
+	// syscall.Errno
+
+	// %v only keeps the message
+	// the chain is broken
+	trace()
+	flattened := fmt.Errorf("while loading the settings: %v", errors.Unwrap(err))
+	fmt.Printf("flattened does not exist: %v\n", errors.Is(flattened, fs.ErrNotExist))
+	// Output:
+	// flattened does not exist: false
+}
+
+// a custom error type carries
+// the details callers need
+// any type with an Error method is an error
+type ValidationError struct {
+	Field  string
+	Value  interface{}
+	Reason string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("invalid %v %v: %v", e.Field, e.Value, e.Reason)
+}
+
+// returns the error interface
+// not *ValidationError
+// a nil *ValidationError would be a non nil error
+func validateCookie(cookie *Cookie) error {
+	if cookie.Size <= 0 {
+		return &ValidationError{"size", cookie.Size, "must be positive"}
+	}
+	if cookie.Rating < 1 || cookie.Rating > 5 {
+		return &ValidationError{"rating", cookie.Rating, "must be between 1 and 5"}
+	}
+	return nil
+}
+
+func bakeCookie(cookie *Cookie) error {
+	if err := validateCookie(cookie); err != nil {
+		return fmt.Errorf("while baking %v: %w", cookie.Flavour, err)
+	}
+	return nil
+}
+
+func customErrorsLesson() {
+
+	// the message comes from the Error method
+	trace()
+	err := bakeCookie(&Cookie{10, "Chocolate", 7})
+	fmt.Println(err)
+	// Output:
+	// while baking Chocolate: invalid rating 7: must be between 1 and 5
+
+	// errors.As finds the custom error
+	// through the wrapping
+	// and gives access to its fields
+	trace()
+	var validationError *ValidationError
+	if errors.As(err, &validationError) {
+		fmt.Printf("field: %v, value: %v\n", validationError.Field, validationError.Value)
+	}
+	// Output:
+	// field: rating, value: 7
+
+	// callers branch on the type of error
+	trace()
+	cookies := CookieSlice{{0, "Peanuts", 4}, {8, "Almonds", 3}}
+	for _, cookie := range cookies {
+		err := bakeCookie(cookie)
+		var validationError *ValidationError
+		switch {
+		case err == nil:
+			fmt.Printf("baked %v\n", cookie.Flavour)
+		case errors.As(err, &validationError):
+			fmt.Printf("fix the %v of %v\n", validationError.Field, cookie.Flavour)
+		default:
+			fmt.Printf("oven failure: %v\n", err)
+		}
+	}
+	// Output:
+	// fix the size of Peanuts
+	// baked Almonds
+}
+
+// a sentinel error is a package level value
+// callers compare the errors they get against it
+var ErrNotFound = errors.New("not found")
+
+// the comma ok of a map lookup
+// turned into a sentinel error
+// wrapped to tell which id was missing
+func findName(nameById map[int]string, id int) (string, error) {
+	name, ok := nameById[id]
+	if !ok {
+		return "", fmt.Errorf("while finding id %v: %w", id, ErrNotFound)
+	}
+	return name, nil
+}
+
+func sentinelsLesson() {
+
+	// looking up values
+	trace()
+	nameById := map[int]string{100: "Alice", 200: "Bob"}
+	name, err := findName(nameById, 100)
+	fmt.Printf("name: %v, err: %v\n", name, err)
+	_, err = findName(nameById, 300)
+	fmt.Println(err)
+	// Output:
+	// name: Alice, err: <nil>
+	// while finding id 300: not found
+
+	// errors.Is matches the sentinel
+	// through the wrapping
+	trace()
+	if errors.Is(err, ErrNotFound) {
+		fmt.Println("not found, using a default name")
+	}
+	// Output:
+	// not found, using a default name
+
+	// == only matches the sentinel itself
+	// not the error wrapping it
+	trace()
+	fmt.Printf("equal: %v\n", err == ErrNotFound)
+	// Output:
+	// equal: false
+
+	// comparing messages breaks
+	// as soon as context is added
+	trace()
+	fmt.Printf("same message: %v\n", err.Error() == "not found")
+	// Output:
+	// same message: false
+
+	// and matches errors that only look alike
+	// two errors.New are distinct values
+	trace()
+	lookalike := errors.New("not found")
+	fmt.Printf("same message: %v\n", lookalike.Error() == ErrNotFound.Error())
+	fmt.Printf("is: %v\n", errors.Is(lookalike, ErrNotFound))
+	// Output:
+	// same message: true
+	// is: false
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"errors"
+	"io/fs"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadSettingsWraps(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "missing.json")
+	err := loadSettings(path)
+	if !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("loadSettings(missing) = %v, want an fs.ErrNotExist", err)
+	}
+
+	var pathError *fs.PathError
+	if !errors.As(err, &pathError) || pathError.Path != path {
+		t.Errorf("loadSettings(missing) = %v, want a *fs.PathError for %v", err, path)
+	}
+
+	if err := loadSettings(t.TempDir()); err != nil {
+		t.Errorf("loadSettings(existing) = %v, want nil", err)
+	}
+}
+
+func TestValidateCookie(t *testing.T) {
+	tests := []struct {
+		cookie Cookie
+		field  string
+	}{
+		{Cookie{10, "Chocolate", 5}, ""},
+		{Cookie{0, "Chocolate", 5}, "size"},
+		{Cookie{10, "Chocolate", 0}, "rating"},
+		{Cookie{10, "Chocolate", 6}, "rating"},
+	}
+	for _, test := range tests {
+		err := bakeCookie(&test.cookie)
+		var validationError *ValidationError
+		if test.field == "" {
+			if err != nil {
+				t.Errorf("bakeCookie(%v) = %v, want nil", test.cookie, err)
+			}
+			continue
+		}
+		if !errors.As(err, &validationError) || validationError.Field != test.field {
+			t.Errorf("bakeCookie(%v) = %v, want a ValidationError on %v", test.cookie, err, test.field)
+		}
+	}
+}
+
+func TestFindName(t *testing.T) {
+	nameById := map[int]string{100: "Alice"}
+	if name, err := findName(nameById, 100); name != "Alice" || err != nil {
+		t.Errorf("findName(100) = %v, %v, want Alice, nil", name, err)
+	}
+	if _, err := findName(nameById, 200); !errors.Is(err, ErrNotFound) {
+		t.Errorf("findName(200) = %v, want ErrNotFound", err)
+	}
+}
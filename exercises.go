@@ -0,0 +1,112 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// practicing what a lesson teaches
+// go run . -exercise=slices-1
+// implement the stub then run its exercise
+// the checker tries it against hidden test cases
+// the stubs live in the exercises file of their chapter
+type Exercise struct {
+	Name   string
+	Lesson string
+	Prompt string
+	Check  func() error
+}
+
+var registeredExercises []Exercise
+
+func RegisterExercise(exercise Exercise) {
+	for _, registered := range registeredExercises {
+		if registered.Name == exercise.Name {
+			panic(fmt.Sprintf("exercise %v registered twice", exercise.Name))
+		}
+	}
+	registeredExercises = append(registeredExercises, exercise)
+}
+
+// exercises are listed in the order of their lessons
+// since files initialize in name order
+func ListExercises() []Exercise {
+	order := make(map[string]int)
+	for i, lesson := range List() {
+		order[lesson.Name] = i + 1
+	}
+	position := func(exercise Exercise) int {
+		if i, ok := order[exercise.Lesson]; ok {
+			return i
+		}
+		return len(order) + 1
+	}
+
+	exercises := make([]Exercise, len(registeredExercises))
+	copy(exercises, registeredExercises)
+	sort.SliceStable(exercises, func(i, j int) bool {
+		return position(exercises[i]) < position(exercises[j])
+	})
+	return exercises
+}
+
+// every lesson has at least one exercise
+// except these ones about tooling and the runtime
+// where there is no function to implement
+var lessonsWithoutExercises = map[string]string{
+	"stacks":         "goroutine dumps are read, not written",
+	"encapsulation":  "visibility needs a second package",
+	"unsafe":         "sizes and offsets depend on the platform",
+	"uintptr":        "pointer arithmetic is best left alone",
+	"cgo":            "needs a C compiler",
+	"initialization": "init order is a property of the build",
+	"visibility":     "visibility needs a second package",
+	"embed":          "go:embed takes a directive, not code",
+	"build-tags":     "build constraints take a directive, not code",
+	"generate":       "go generate runs tools, not code",
+}
+
+// a panicking implementation
+// fails instead of crashing the runner
+func RunExercise(name string) (err error) {
+	for _, exercise := range registeredExercises {
+		if exercise.Name != name {
+			continue
+		}
+		fmt.Printf("%v: %v\n", exercise.Name, exercise.Prompt)
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				err = fmt.Errorf("panicked: %v", recovered)
+			}
+		}()
+		return exercise.Check()
+	}
+	return fmt.Errorf("unknown exercise %v", name)
+}
+
+// the concurrency checkers give up
+// on an implementation that hangs
+// instead of hanging the runner
+// a panic is raised again in the checker
+// where RunExercise recovers it
+const hangLimit = time.Second
+
+func finishesWithin(limit time.Duration, run func()) bool {
+	done := make(chan interface{}, 1)
+	go func() {
+		defer func() {
+			done <- recover()
+		}()
+		run()
+	}()
+	select {
+	case recovered := <-done:
+		if recovered != nil {
+			panic(recovered)
+		}
+		return true
+	case <-time.After(limit):
+		return false
+	}
+}
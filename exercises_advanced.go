@@ -0,0 +1,83 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+)
+
+func init() {
+	RegisterExercise(Exercise{"reflection-1", "reflection", "set a struct field by its name",
+		func() error { return checkSetField(SetField) }})
+	RegisterExercise(Exercise{"logging-1", "logging", "log a payment with its id and amount as attributes",
+		func() error { return checkLogPayment(LogPayment) }})
+}
+
+// reflection-1
+// target is a pointer to a struct
+// fail on anything else, an unknown field
+// or a value of the wrong type
+func SetField(target any, name string, value any) error {
+	// your code here
+	return nil
+}
+
+func checkSetField(setField func(any, string, any) error) error {
+	cookie := Cookie{Size: 3, Flavour: "Vanilla"}
+	if err := setField(&cookie, "Flavour", "Chocolate"); err != nil || cookie.Flavour != "Chocolate" {
+		return fmt.Errorf("SetField(Flavour, Chocolate) = %v leaving %+v", err, cookie)
+	}
+	var tests = []struct {
+		target any
+		name   string
+		value  any
+	}{
+		{cookie, "Size", 5},
+		{&cookie, "Weight", 5},
+		{&cookie, "Size", "large"},
+		{new(int), "Size", 5},
+	}
+	for _, test := range tests {
+		if err := setField(test.target, test.name, test.value); err == nil {
+			return fmt.Errorf("SetField(%T, %v, %#v) returned no error", test.target, test.name, test.value)
+		}
+	}
+	return nil
+}
+
+// logging-1
+// a "payment" record with id and amount attributes
+// logged at the warn level above 1000
+func LogPayment(logger *slog.Logger, id string, amount int) {
+	// your code here
+}
+
+func checkLogPayment(logPayment func(*slog.Logger, string, int)) error {
+	var tests = []struct {
+		id     string
+		amount int
+		level  string
+	}{
+		{"p-1", 20, "INFO"},
+		{"p-2", 5000, "WARN"},
+	}
+	for _, test := range tests {
+		var buffer bytes.Buffer
+		logPayment(slog.New(slog.NewJSONHandler(&buffer, nil)), test.id, test.amount)
+		var record struct {
+			Level  string
+			Msg    string
+			ID     string `json:"id"`
+			Amount int    `json:"amount"`
+		}
+		if err := json.Unmarshal(buffer.Bytes(), &record); err != nil {
+			return fmt.Errorf("LogPayment(%v, %v) logged %q: %v", test.id, test.amount, strings.TrimSpace(buffer.String()), err)
+		}
+		if record.Level != test.level || record.Msg != "payment" || record.ID != test.id || record.Amount != test.amount {
+			return fmt.Errorf("LogPayment(%v, %v) logged %v", test.id, test.amount, strings.TrimSpace(buffer.String()))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"testing"
+)
+
+func TestAdvancedExerciseCheckers(t *testing.T) {
+	testCheckers(t, []checkerTest{
+		{
+			"reflection-1",
+			func() error {
+				return checkSetField(func(target any, name string, value any) error {
+					pointer := reflect.ValueOf(target)
+					if pointer.Kind() != reflect.Pointer || pointer.Elem().Kind() != reflect.Struct {
+						return fmt.Errorf("%T is not a pointer to a struct", target)
+					}
+					field := pointer.Elem().FieldByName(name)
+					if !field.IsValid() {
+						return fmt.Errorf("no field %v", name)
+					}
+					newValue := reflect.ValueOf(value)
+					if !newValue.Type().AssignableTo(field.Type()) {
+						return fmt.Errorf("cannot set %v to a %T", name, value)
+					}
+					field.Set(newValue)
+					return nil
+				})
+			},
+			func() error {
+				return checkSetField(func(target any, name string, value any) error {
+					pointer := reflect.ValueOf(target)
+					if pointer.Kind() != reflect.Pointer || pointer.Elem().Kind() != reflect.Struct {
+						return fmt.Errorf("%T is not a pointer to a struct", target)
+					}
+					field := pointer.Elem().FieldByName(name)
+					if !field.IsValid() || !reflect.TypeOf(value).AssignableTo(field.Type()) {
+						return fmt.Errorf("cannot set %v to a %T", name, value)
+					}
+
+					// setting a copy of the field
+					// leaves the struct alone
+					copied := reflect.New(field.Type()).Elem()
+					copied.Set(reflect.ValueOf(value))
+					return nil
+				})
+			},
+		},
+		{
+			"logging-1",
+			func() error {
+				return checkLogPayment(func(logger *slog.Logger, id string, amount int) {
+					level := slog.LevelInfo
+					if amount > 1000 {
+						level = slog.LevelWarn
+					}
+					logger.Log(context.Background(), level, "payment", "id", id, "amount", amount)
+				})
+			},
+			func() error {
+				return checkLogPayment(func(logger *slog.Logger, id string, amount int) {
+					logger.Info(fmt.Sprintf("payment %v of %v", id, amount))
+				})
+			},
+		},
+	})
+}
@@ -0,0 +1,289 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+)
+
+func init() {
+	RegisterExercise(Exercise{"variables-1", "variables", "swap two words in a single assignment",
+		func() error { return checkSwapWords(SwapWords) }})
+	RegisterExercise(Exercise{"shadowing-1", "shadowing", "sum the quantities and return the first parse error",
+		func() error { return checkSumQuantities(SumQuantities) }})
+	RegisterExercise(Exercise{"types-1", "types", "name the toppings set in the bit flags",
+		func() error { return checkToppingNames(ToppingNames) }})
+	RegisterExercise(Exercise{"loops-1", "loops", "count down from a number by a step while it stays positive",
+		func() error { return checkCountdown(Countdown) }})
+	RegisterExercise(Exercise{"labels-1", "labels", "find the first negative value of a grid",
+		func() error { return checkFirstNegative(FirstNegative) }})
+	RegisterExercise(Exercise{"switches-1", "switches", "grade a score from 0 to 100",
+		func() error { return checkGrade(Grade) }})
+	RegisterExercise(Exercise{"bits-1", "bits", "tell whether a number is a power of two",
+		func() error { return checkIsPowerOfTwo(IsPowerOfTwo) }})
+	RegisterExercise(Exercise{"integers-1", "integers", "add two int8 reporting whether the sum overflowed",
+		func() error { return checkAddInt8(AddInt8) }})
+	RegisterExercise(Exercise{"floats-1", "floats", "compare two floats within a tolerance",
+		func() error { return checkAlmostEqual(AlmostEqual) }})
+	RegisterExercise(Exercise{"constants-1", "constants", "print a size with the largest unit it reaches",
+		func() error { return checkHumanSize(HumanSize) }})
+	RegisterExercise(Exercise{"comparing-1", "comparing", "compare two major, minor and patch versions",
+		func() error { return checkCompareVersions(CompareVersions) }})
+}
+
+// variables-1
+func SwapWords(first, second string) (string, string) {
+	// your code here
+	return first, second
+}
+
+func checkSwapWords(swapWords func(string, string) (string, string)) error {
+	if first, second := swapWords("hello", "world"); first != "world" || second != "hello" {
+		return fmt.Errorf("SwapWords(hello, world) = %v, %v, want world, hello", first, second)
+	}
+	return nil
+}
+
+// shadowing-1
+// := inside the loop would declare
+// a new err hiding the result
+func SumQuantities(inputs []string) (sum int, err error) {
+	// your code here
+	return 0, nil
+}
+
+func checkSumQuantities(sumQuantities func([]string) (int, error)) error {
+	if sum, err := sumQuantities([]string{"1", "2", "3"}); sum != 6 || err != nil {
+		return fmt.Errorf("SumQuantities([1 2 3]) = %v, %v, want 6, nil", sum, err)
+	}
+	_, err := sumQuantities([]string{"1", "two", "3", "four"})
+	if !errors.Is(err, strconv.ErrSyntax) {
+		return fmt.Errorf("SumQuantities([1 two 3 four]) returned %v, want the syntax error of two", err)
+	}
+	if numError := (*strconv.NumError)(nil); !errors.As(err, &numError) || numError.Num != "two" {
+		return fmt.Errorf("SumQuantities([1 two 3 four]) returned %v, want the first error", err)
+	}
+	return nil
+}
+
+// types-1
+// in the order Sprinkles, Caramel, Nuts
+func ToppingNames(toppings Topping) []string {
+	// your code here
+	return nil
+}
+
+func checkToppingNames(toppingNames func(Topping) []string) error {
+	var tests = []struct {
+		toppings Topping
+		want     []string
+	}{
+		{Sprinkles | Nuts, []string{"Sprinkles", "Nuts"}},
+		{Caramel, []string{"Caramel"}},
+		{Nuts | Caramel | Sprinkles, []string{"Sprinkles", "Caramel", "Nuts"}},
+		{0, nil},
+	}
+	for _, test := range tests {
+		if got := toppingNames(test.toppings); !reflect.DeepEqual(got, test.want) {
+			return fmt.Errorf("ToppingNames(%03b) = %v, want %v", test.toppings, got, test.want)
+		}
+	}
+	return nil
+}
+
+// loops-1
+func Countdown(from, step int) []int {
+	// your code here
+	return nil
+}
+
+func checkCountdown(countdown func(int, int) []int) error {
+	var tests = []struct {
+		from, step int
+		want       []int
+	}{
+		{10, 3, []int{10, 7, 4, 1}},
+		{4, 2, []int{4, 2}},
+		{0, 1, nil},
+	}
+	for _, test := range tests {
+		if got := countdown(test.from, test.step); !reflect.DeepEqual(got, test.want) {
+			return fmt.Errorf("Countdown(%v, %v) = %v, want %v", test.from, test.step, got, test.want)
+		}
+	}
+	return nil
+}
+
+// labels-1
+func FirstNegative(grid [][]int) (row, column int, found bool) {
+	// your code here
+	return 0, 0, false
+}
+
+func checkFirstNegative(firstNegative func([][]int) (int, int, bool)) error {
+	var tests = []struct {
+		grid        [][]int
+		row, column int
+		found       bool
+	}{
+		{[][]int{{1, 2}, {3, -4}, {-5, 6}}, 1, 1, true},
+		{[][]int{{-1}}, 0, 0, true},
+		{[][]int{{1, 2}, {3, 4}}, 0, 0, false},
+	}
+	for _, test := range tests {
+		row, column, found := firstNegative(test.grid)
+		if found != test.found || (found && (row != test.row || column != test.column)) {
+			return fmt.Errorf("FirstNegative(%v) = %v, %v, %v, want %v, %v, %v", test.grid, row, column, found, test.row, test.column, test.found)
+		}
+	}
+	return nil
+}
+
+// switches-1
+// A from 90, B from 80, C from 70, F below
+// invalid outside 0 to 100
+func Grade(score int) string {
+	// your code here
+	return ""
+}
+
+func checkGrade(grade func(int) string) error {
+	var tests = []struct {
+		score int
+		want  string
+	}{
+		{100, "A"}, {90, "A"}, {89, "B"}, {80, "B"}, {75, "C"}, {69, "F"}, {0, "F"},
+		{-1, "invalid"}, {101, "invalid"},
+	}
+	for _, test := range tests {
+		if got := grade(test.score); got != test.want {
+			return fmt.Errorf("Grade(%v) = %q, want %q", test.score, got, test.want)
+		}
+	}
+	return nil
+}
+
+// bits-1
+// a power of two has a single bit set
+func IsPowerOfTwo(n uint) bool {
+	// your code here
+	return false
+}
+
+func checkIsPowerOfTwo(isPowerOfTwo func(uint) bool) error {
+	for n := uint(0); n <= 1024; n++ {
+		want := n == 1 || n == 2 || n == 4 || n == 8 || n == 16 || n == 32 || n == 64 || n == 128 || n == 256 || n == 512 || n == 1024
+		if got := isPowerOfTwo(n); got != want {
+			return fmt.Errorf("IsPowerOfTwo(%v) = %v, want %v", n, got, want)
+		}
+	}
+	return nil
+}
+
+// integers-1
+// the sum wraps around on overflow
+func AddInt8(a, b int8) (sum int8, ok bool) {
+	// your code here
+	return a + b, true
+}
+
+func checkAddInt8(addInt8 func(int8, int8) (int8, bool)) error {
+	var tests = []struct {
+		a, b int8
+		sum  int8
+		ok   bool
+	}{
+		{1, 2, 3, true},
+		{100, 27, 127, true},
+		{100, 28, 0, false},
+		{-100, -28, -128, true},
+		{-100, -29, 0, false},
+		{127, -128, -1, true},
+	}
+	for _, test := range tests {
+		sum, ok := addInt8(test.a, test.b)
+		if ok != test.ok || (ok && sum != test.sum) {
+			return fmt.Errorf("AddInt8(%v, %v) = %v, %v, want %v, %v", test.a, test.b, sum, ok, test.sum, test.ok)
+		}
+	}
+	return nil
+}
+
+// floats-1
+// NaN equals nothing, not even itself
+func AlmostEqual(a, b, tolerance float64) bool {
+	// your code here
+	return a == b
+}
+
+func checkAlmostEqual(almostEqual func(float64, float64, float64) bool) error {
+	var tests = []struct {
+		a, b, tolerance float64
+		want            bool
+	}{
+		{0.1 + 0.2, 0.3, 1e-9, true},
+		{1, 1.1, 0.01, false},
+		{-2, -2.005, 0.01, true},
+		{math.NaN(), math.NaN(), 1, false},
+	}
+	for _, test := range tests {
+		if got := almostEqual(test.a, test.b, test.tolerance); got != test.want {
+			return fmt.Errorf("AlmostEqual(%v, %v, %v) = %v, want %v", test.a, test.b, test.tolerance, got, test.want)
+		}
+	}
+	return nil
+}
+
+// constants-1
+// the units are the KB, MB and GB constants
+func HumanSize(size ByteSize) string {
+	// your code here
+	return ""
+}
+
+func checkHumanSize(humanSize func(ByteSize) string) error {
+	var tests = []struct {
+		size ByteSize
+		want string
+	}{
+		{512, "512 B"},
+		{KB, "1 KB"},
+		{1536, "1.5 KB"},
+		{3 * MB, "3 MB"},
+		{2*GB + GB/4, "2.25 GB"},
+	}
+	for _, test := range tests {
+		if got := humanSize(test.size); got != test.want {
+			return fmt.Errorf("HumanSize(%v) = %q, want %q", int64(test.size), got, test.want)
+		}
+	}
+	return nil
+}
+
+// comparing-1
+// -1, 0 or +1 like cmp.Compare
+// cmp.Or returns its first non zero argument
+func CompareVersions(a, b [3]int) int {
+	// your code here
+	return 0
+}
+
+func checkCompareVersions(compareVersions func([3]int, [3]int) int) error {
+	var tests = []struct {
+		a, b [3]int
+		want int
+	}{
+		{[3]int{1, 2, 3}, [3]int{1, 2, 3}, 0},
+		{[3]int{1, 2, 3}, [3]int{1, 10, 0}, -1},
+		{[3]int{2, 0, 0}, [3]int{1, 99, 99}, 1},
+		{[3]int{1, 2, 4}, [3]int{1, 2, 3}, 1},
+	}
+	for _, test := range tests {
+		if got := compareVersions(test.a, test.b); got != test.want {
+			return fmt.Errorf("CompareVersions(%v, %v) = %v, want %v", test.a, test.b, got, test.want)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,229 @@
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"strconv"
+	"testing"
+)
+
+func TestBasicsExerciseCheckers(t *testing.T) {
+	testCheckers(t, []checkerTest{
+		{
+			"variables-1",
+			func() error {
+				return checkSwapWords(func(first, second string) (string, string) {
+					first, second = second, first
+					return first, second
+				})
+			},
+			func() error {
+				return checkSwapWords(func(first, second string) (string, string) {
+					first = second
+					second = first
+					return first, second
+				})
+			},
+		},
+		{
+			"shadowing-1",
+			func() error {
+				return checkSumQuantities(func(inputs []string) (sum int, err error) {
+					for _, input := range inputs {
+						var quantity int
+						quantity, err = strconv.Atoi(input)
+						if err != nil {
+							return sum, err
+						}
+						sum += quantity
+					}
+					return sum, nil
+				})
+			},
+			func() error {
+				return checkSumQuantities(func(inputs []string) (sum int, err error) {
+					for _, input := range inputs {
+						quantity, err := strconv.Atoi(input)
+						if err != nil {
+							continue
+						}
+						sum += quantity
+					}
+					return sum, err
+				})
+			},
+		},
+		{
+			"types-1",
+			func() error {
+				return checkToppingNames(func(toppings Topping) []string {
+					var names []string
+					for i, name := range []string{"Sprinkles", "Caramel", "Nuts"} {
+						if toppings&(1<<i) != 0 {
+							names = append(names, name)
+						}
+					}
+					return names
+				})
+			},
+			func() error {
+				return checkToppingNames(func(toppings Topping) []string {
+					var names []string
+					if toppings&Nuts != 0 {
+						names = append(names, "Nuts")
+					}
+					if toppings&Sprinkles != 0 {
+						names = append(names, "Sprinkles")
+					}
+					if toppings&Caramel != 0 {
+						names = append(names, "Caramel")
+					}
+					return names
+				})
+			},
+		},
+		{
+			"loops-1",
+			func() error {
+				return checkCountdown(func(from, step int) []int {
+					var values []int
+					for value := from; value > 0; value -= step {
+						values = append(values, value)
+					}
+					return values
+				})
+			},
+			func() error {
+				return checkCountdown(func(from, step int) []int {
+					var values []int
+					for value := from; value >= 0; value -= step {
+						values = append(values, value)
+					}
+					return values
+				})
+			},
+		},
+		{
+			"labels-1",
+			func() error {
+				return checkFirstNegative(func(grid [][]int) (int, int, bool) {
+					for row := range grid {
+						for column := range grid[row] {
+							if grid[row][column] < 0 {
+								return row, column, true
+							}
+						}
+					}
+					return 0, 0, false
+				})
+			},
+			func() error {
+				return checkFirstNegative(func(grid [][]int) (row, column int, found bool) {
+				rows:
+					for row = range grid {
+						for column = range grid[row] {
+							if grid[row][column] < 0 {
+								found = true
+								continue rows
+							}
+						}
+					}
+					return row, column, found
+				})
+			},
+		},
+		{
+			"switches-1",
+			func() error {
+				return checkGrade(func(score int) string {
+					switch {
+					case score < 0 || score > 100:
+						return "invalid"
+					case score >= 90:
+						return "A"
+					case score >= 80:
+						return "B"
+					case score >= 70:
+						return "C"
+					default:
+						return "F"
+					}
+				})
+			},
+			func() error {
+				return checkGrade(func(score int) string {
+					switch {
+					case score > 90:
+						return "A"
+					case score > 80:
+						return "B"
+					case score > 70:
+						return "C"
+					default:
+						return "F"
+					}
+				})
+			},
+		},
+		{
+			"bits-1",
+			func() error { return checkIsPowerOfTwo(func(n uint) bool { return n != 0 && n&(n-1) == 0 }) },
+			func() error { return checkIsPowerOfTwo(func(n uint) bool { return n&(n-1) == 0 }) },
+		},
+		{
+			"integers-1",
+			func() error {
+				return checkAddInt8(func(a, b int8) (int8, bool) {
+					sum := a + b
+					return sum, (a >= 0) != (b >= 0) || (sum >= 0) == (a >= 0)
+				})
+			},
+			func() error { return checkAddInt8(func(a, b int8) (int8, bool) { return a + b, a+b >= a }) },
+		},
+		{
+			"floats-1",
+			func() error {
+				return checkAlmostEqual(func(a, b, tolerance float64) bool { return math.Abs(a-b) <= tolerance })
+			},
+			func() error { return checkAlmostEqual(func(a, b, tolerance float64) bool { return a == b }) },
+		},
+		{
+			"constants-1",
+			func() error {
+				return checkHumanSize(func(size ByteSize) string {
+					for _, unit := range []struct {
+						size ByteSize
+						name string
+					}{{GB, "GB"}, {MB, "MB"}, {KB, "KB"}} {
+						if size >= unit.size {
+							return fmt.Sprintf("%v %v", float64(size)/float64(unit.size), unit.name)
+						}
+					}
+					return fmt.Sprintf("%v B", int64(size))
+				})
+			},
+			func() error {
+				return checkHumanSize(func(size ByteSize) string {
+					if size >= KB {
+						return fmt.Sprintf("%v KB", size/KB)
+					}
+					return fmt.Sprintf("%v B", int64(size))
+				})
+			},
+		},
+		{
+			"comparing-1",
+			func() error {
+				return checkCompareVersions(func(a, b [3]int) int {
+					return cmp.Or(cmp.Compare(a[0], b[0]), cmp.Compare(a[1], b[1]), cmp.Compare(a[2], b[2]))
+				})
+			},
+			func() error {
+				return checkCompareVersions(func(a, b [3]int) int {
+					return cmp.Compare(a[0]+a[1]+a[2], b[0]+b[1]+b[2])
+				})
+			},
+		},
+	})
+}
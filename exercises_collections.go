@@ -0,0 +1,194 @@
+package main
+
+import (
+	"fmt"
+	"reflect"
+)
+
+func init() {
+	RegisterExercise(Exercise{"arrays-1", "arrays", "rotate an array one position to the left",
+		func() error { return checkRotateLeft(RotateLeft) }})
+	RegisterExercise(Exercise{"slices-1", "slices", "remove the element at an index keeping the order of the others",
+		func() error { return checkRemoveAt(RemoveAt) }})
+	RegisterExercise(Exercise{"slices-2", "slices", "reverse a slice in place",
+		func() error { return checkReverse(Reverse) }})
+	RegisterExercise(Exercise{"slices-package-1", "slices-package", "sort the values and drop the duplicates without changing the input",
+		func() error { return checkDedupe(Dedupe) }})
+	RegisterExercise(Exercise{"maps-1", "maps", "count the occurrences of each word",
+		func() error { return checkCountWords(CountWords) }})
+	RegisterExercise(Exercise{"copies-1", "copies", "copy a matrix so that changing the copy leaves the original alone",
+		func() error { return checkCloneMatrix(CloneMatrix) }})
+	RegisterExercise(Exercise{"nil-collections-1", "nil-collections", "count words into a map that may be nil",
+		func() error { return checkAddAll(AddAll) }})
+}
+
+// arrays-1
+// arrays are values
+// the caller keeps its own array
+func RotateLeft(values [5]int) [5]int {
+	// your code here
+	return values
+}
+
+func checkRotateLeft(rotateLeft func([5]int) [5]int) error {
+	var tests = []struct {
+		values [5]int
+		want   [5]int
+	}{
+		{[5]int{1, 2, 3, 4, 5}, [5]int{2, 3, 4, 5, 1}},
+		{[5]int{7, 7, 8, 7, 7}, [5]int{7, 8, 7, 7, 7}},
+	}
+	for _, test := range tests {
+		input := test.values
+		if got := rotateLeft(test.values); got != test.want || test.values != input {
+			return fmt.Errorf("RotateLeft(%v) = %v leaving %v, want %v", input, got, test.values, test.want)
+		}
+	}
+	return nil
+}
+
+// slices-1
+func RemoveAt(slice []int, index int) []int {
+	// your code here
+	return slice
+}
+
+func checkRemoveAt(removeAt func([]int, int) []int) error {
+	var tests = []struct {
+		slice []int
+		index int
+		want  []int
+	}{
+		{[]int{1}, 0, []int{}},
+		{[]int{1, 2, 3}, 0, []int{2, 3}},
+		{[]int{1, 2, 3}, 1, []int{1, 3}},
+		{[]int{1, 2, 3}, 2, []int{1, 2}},
+	}
+	for _, test := range tests {
+		input := fmt.Sprint(test.slice)
+		if got := removeAt(test.slice, test.index); fmt.Sprint(got) != fmt.Sprint(test.want) {
+			return fmt.Errorf("RemoveAt(%v, %v) = %v, want %v", input, test.index, got, test.want)
+		}
+	}
+	return nil
+}
+
+// slices-2
+func Reverse(slice []int) {
+	// your code here
+}
+
+func checkReverse(reverse func([]int)) error {
+	var tests = []struct {
+		slice []int
+		want  []int
+	}{
+		{[]int{}, []int{}},
+		{[]int{1}, []int{1}},
+		{[]int{1, 2}, []int{2, 1}},
+		{[]int{1, 2, 3, 4, 5}, []int{5, 4, 3, 2, 1}},
+	}
+	for _, test := range tests {
+		input := fmt.Sprint(test.slice)
+		if reverse(test.slice); !reflect.DeepEqual(test.slice, test.want) {
+			return fmt.Errorf("Reverse(%v) left %v, want %v", input, test.slice, test.want)
+		}
+	}
+	return nil
+}
+
+// slices-package-1
+// slices.Clone, slices.Sort and slices.Compact
+func Dedupe(values []int) []int {
+	// your code here
+	return values
+}
+
+func checkDedupe(dedupe func([]int) []int) error {
+	var tests = []struct {
+		values []int
+		want   []int
+	}{
+		{[]int{}, []int{}},
+		{[]int{3, 1, 2}, []int{1, 2, 3}},
+		{[]int{2, 1, 2, 3, 1, 2}, []int{1, 2, 3}},
+	}
+	for _, test := range tests {
+		input := fmt.Sprint(test.values)
+		got := dedupe(test.values)
+		if fmt.Sprint(got) != fmt.Sprint(test.want) || fmt.Sprint(test.values) != input {
+			return fmt.Errorf("Dedupe(%v) = %v leaving %v, want %v", input, got, test.values, test.want)
+		}
+	}
+	return nil
+}
+
+// maps-1
+// words are separated by spaces
+func CountWords(text string) map[string]int {
+	// your code here
+	return nil
+}
+
+func checkCountWords(countWords func(string) map[string]int) error {
+	var tests = []struct {
+		text string
+		want map[string]int
+	}{
+		{"", map[string]int{}},
+		{"go", map[string]int{"go": 1}},
+		{"go go gopher", map[string]int{"go": 2, "gopher": 1}},
+		{"a b a c b a", map[string]int{"a": 3, "b": 2, "c": 1}},
+	}
+	for _, test := range tests {
+		got := countWords(test.text)
+		if len(got) != len(test.want) || (len(got) > 0 && !reflect.DeepEqual(got, test.want)) {
+			return fmt.Errorf("CountWords(%q) = %v, want %v", test.text, got, test.want)
+		}
+	}
+	return nil
+}
+
+// copies-1
+// copying the outer slice
+// still shares the rows
+func CloneMatrix(matrix [][]int) [][]int {
+	// your code here
+	return matrix
+}
+
+func checkCloneMatrix(cloneMatrix func([][]int) [][]int) error {
+	matrix := [][]int{{1, 2}, {3, 4}}
+	clone := cloneMatrix(matrix)
+	if !reflect.DeepEqual(clone, [][]int{{1, 2}, {3, 4}}) {
+		return fmt.Errorf("CloneMatrix([[1 2] [3 4]]) = %v", clone)
+	}
+	clone[0][0] = 9
+	clone[1] = append(clone[1], 5)
+	if !reflect.DeepEqual(matrix, [][]int{{1, 2}, {3, 4}}) {
+		return fmt.Errorf("changing the clone changed the original to %v", matrix)
+	}
+	return nil
+}
+
+// nil-collections-1
+// reading a nil map is fine
+// writing to one panics
+func AddAll(counts map[string]int, words []string) map[string]int {
+	// your code here
+	return counts
+}
+
+func checkAddAll(addAll func(map[string]int, []string) map[string]int) error {
+	if got := addAll(nil, []string{"go", "go"}); !reflect.DeepEqual(got, map[string]int{"go": 2}) {
+		return fmt.Errorf("AddAll(nil, [go go]) = %v, want map[go:2]", got)
+	}
+	if got := addAll(nil, nil); got != nil {
+		return fmt.Errorf("AddAll(nil, nil) = %v, want a nil map when there is nothing to add", got)
+	}
+	counts := map[string]int{"go": 1}
+	if got := addAll(counts, []string{"go", "gopher"}); !reflect.DeepEqual(got, map[string]int{"go": 2, "gopher": 1}) || counts["go"] != 2 {
+		return fmt.Errorf("AddAll(map[go:1], [go gopher]) = %v, want map[go:2 gopher:1] in the same map", got)
+	}
+	return nil
+}
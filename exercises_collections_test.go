@@ -0,0 +1,117 @@
+package main
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestCollectionsExerciseCheckers(t *testing.T) {
+	testCheckers(t, []checkerTest{
+		{
+			"arrays-1",
+			func() error {
+				return checkRotateLeft(func(values [5]int) [5]int {
+					first := values[0]
+					copy(values[:], values[1:])
+					values[4] = first
+					return values
+				})
+			},
+			func() error {
+				return checkRotateLeft(func(values [5]int) [5]int {
+					last := values[4]
+					copy(values[1:], values[:4])
+					values[0] = last
+					return values
+				})
+			},
+		},
+		{
+			"slices-1",
+			func() error {
+				return checkRemoveAt(func(slice []int, index int) []int {
+					copy(slice[index:], slice[index+1:])
+					return slice[:len(slice)-1]
+				})
+			},
+			func() error { return checkRemoveAt(func(slice []int, index int) []int { return slice[1:] }) },
+		},
+		{
+			"slices-2",
+			func() error {
+				return checkReverse(func(slice []int) {
+					for i, j := 0, len(slice)-1; i < j; i, j = i+1, j-1 {
+						slice[i], slice[j] = slice[j], slice[i]
+					}
+				})
+			},
+			func() error { return checkReverse(func(slice []int) {}) },
+		},
+		{
+			"slices-package-1",
+			func() error {
+				return checkDedupe(func(values []int) []int {
+					values = slices.Clone(values)
+					slices.Sort(values)
+					return slices.Compact(values)
+				})
+			},
+			func() error {
+				return checkDedupe(func(values []int) []int {
+					slices.Sort(values)
+					return slices.Compact(values)
+				})
+			},
+		},
+		{
+			"maps-1",
+			func() error {
+				return checkCountWords(func(text string) map[string]int {
+					counts := make(map[string]int)
+					for _, word := range strings.Fields(text) {
+						counts[word]++
+					}
+					return counts
+				})
+			},
+			func() error { return checkCountWords(func(text string) map[string]int { return nil }) },
+		},
+		{
+			"copies-1",
+			func() error {
+				return checkCloneMatrix(func(matrix [][]int) [][]int {
+					clone := make([][]int, len(matrix))
+					for i, row := range matrix {
+						clone[i] = slices.Clone(row)
+					}
+					return clone
+				})
+			},
+			func() error { return checkCloneMatrix(slices.Clone[[][]int]) },
+		},
+		{
+			"nil-collections-1",
+			func() error {
+				return checkAddAll(func(counts map[string]int, words []string) map[string]int {
+					for _, word := range words {
+						if counts == nil {
+							counts = make(map[string]int)
+						}
+						counts[word]++
+					}
+					return counts
+				})
+			},
+			func() error {
+				return checkAddAll(func(counts map[string]int, words []string) map[string]int {
+					added := make(map[string]int)
+					for _, word := range words {
+						added[word]++
+					}
+					return added
+				})
+			},
+		},
+	})
+}
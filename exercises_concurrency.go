@@ -0,0 +1,383 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+func init() {
+	RegisterExercise(Exercise{"goroutines-1", "goroutines", "run a task in a goroutine and close a channel when it is done",
+		func() error { return checkGo(Go) }})
+	RegisterExercise(Exercise{"waitgroups-1", "waitgroups", "run every task concurrently and wait for all of them",
+		func() error { return checkRunAll(RunAll) }})
+	RegisterExercise(Exercise{"channels-1", "channels", "send the numbers from 1 to n on a channel then close it",
+		func() error { return checkGenerate(Generate) }})
+	RegisterExercise(Exercise{"loopvar-1", "loopvar", "return closures each returning their own index",
+		func() error { return checkClosures(Closures) }})
+	RegisterExercise(Exercise{"select-1", "select", "receive a value without blocking",
+		func() error { return checkReceiveOrDefault(ReceiveOrDefault) }})
+	RegisterExercise(Exercise{"workerpools-1", "workerpools", "process values with a limited number of workers keeping their order",
+		func() error { return checkProcessAll(ProcessAll) }})
+	RegisterExercise(Exercise{"fan-out-1", "fan-out", "merge channels into one closed after all of them",
+		func() error { return checkMergeInts(MergeInts) }})
+	RegisterExercise(Exercise{"pipelines-1", "pipelines", "square values until the input closes or the context is canceled",
+		func() error { return checkSquareUntil(SquareUntil) }})
+	RegisterExercise(Exercise{"sync-1", "sync", "count by key from many goroutines",
+		func() error {
+			return checkSafeCounter(func() (func(string), func(string) int) {
+				counter := NewSafeCounter()
+				return counter.Inc, counter.Value
+			})
+		}})
+	RegisterExercise(Exercise{"atomics-1", "atomics", "count from many goroutines with an atomic",
+		func() error { return checkCountConcurrently(CountConcurrently) }})
+	RegisterExercise(Exercise{"cond-1", "cond", "block on a gate until it opens",
+		func() error {
+			return checkGate(func() (func(), func()) {
+				gate := NewGate()
+				return gate.Wait, gate.Open
+			})
+		}})
+}
+
+// goroutines-1
+func Go(task func()) <-chan struct{} {
+	// your code here
+	return nil
+}
+
+func checkGo(goTask func(func()) <-chan struct{}) error {
+	release := make(chan struct{})
+	var ran bool
+	var done <-chan struct{}
+	if !finishesWithin(hangLimit, func() {
+		done = goTask(func() {
+			<-release
+			ran = true
+		})
+	}) {
+		return fmt.Errorf("Go waited for the task to finish")
+	}
+	close(release)
+	if !finishesWithin(hangLimit, func() { <-done }) {
+		return fmt.Errorf("the channel of Go was not closed after the task finished")
+	}
+	if !ran {
+		return fmt.Errorf("the channel of Go was closed before the task finished")
+	}
+	return nil
+}
+
+// waitgroups-1
+func RunAll(tasks []func()) {
+	// your code here
+}
+
+func checkRunAll(runAll func([]func())) error {
+
+	// every task waits for the others
+	// so running them one by one hangs
+	const count = 4
+	var barrier sync.WaitGroup
+	barrier.Add(count)
+	var finished atomic.Int32
+	tasks := make([]func(), count)
+	for i := range tasks {
+		tasks[i] = func() {
+			barrier.Done()
+			barrier.Wait()
+			finished.Add(1)
+		}
+	}
+	if !finishesWithin(hangLimit, func() { runAll(tasks) }) {
+		return fmt.Errorf("RunAll did not run the tasks concurrently")
+	}
+	if got := finished.Load(); got != count {
+		return fmt.Errorf("RunAll returned after %v of %v tasks", got, count)
+	}
+	return nil
+}
+
+// channels-1
+func Generate(n int) <-chan int {
+	// your code here
+	return nil
+}
+
+func checkGenerate(generate func(int) <-chan int) error {
+	var got []int
+	if !finishesWithin(hangLimit, func() {
+		for value := range generate(5) {
+			got = append(got, value)
+		}
+	}) {
+		return fmt.Errorf("the channel of Generate(5) was not closed")
+	}
+	if !reflect.DeepEqual(got, []int{1, 2, 3, 4, 5}) {
+		return fmt.Errorf("Generate(5) sent %v, want [1 2 3 4 5]", got)
+	}
+	return nil
+}
+
+// loopvar-1
+func Closures(n int) []func() int {
+	// your code here
+	return nil
+}
+
+func checkClosures(closures func(int) []func() int) error {
+	funcs := closures(3)
+	if len(funcs) != 3 {
+		return fmt.Errorf("Closures(3) returned %v closures, want 3", len(funcs))
+	}
+	for i, f := range funcs {
+		if got := f(); got != i {
+			return fmt.Errorf("closure %v returned %v", i, got)
+		}
+	}
+	return nil
+}
+
+// select-1
+// the fallback when nothing is ready
+func ReceiveOrDefault(values <-chan int, fallback int) int {
+	// your code here
+	return <-values
+}
+
+func checkReceiveOrDefault(receiveOrDefault func(<-chan int, int) int) error {
+	values := make(chan int, 1)
+	values <- 42
+	if got := receiveOrDefault(values, -1); got != 42 {
+		return fmt.Errorf("ReceiveOrDefault of a ready channel = %v, want 42", got)
+	}
+	var got int
+	if !finishesWithin(hangLimit, func() { got = receiveOrDefault(values, -1) }) {
+		return fmt.Errorf("ReceiveOrDefault blocked on an empty channel")
+	}
+	if got != -1 {
+		return fmt.Errorf("ReceiveOrDefault of an empty channel = %v, want -1", got)
+	}
+	return nil
+}
+
+// workerpools-1
+// the results are in the order of the values
+// no more than workers values are processed at once
+func ProcessAll(values []int, workers int, process func(int) int) []int {
+	// your code here
+	return nil
+}
+
+func checkProcessAll(processAll func([]int, int, func(int) int) []int) error {
+	var running, mostRunning atomic.Int32
+	double := func(value int) int {
+		now := running.Add(1)
+		defer running.Add(-1)
+		for {
+			most := mostRunning.Load()
+			if now <= most || mostRunning.CompareAndSwap(most, now) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		return value * 2
+	}
+	var got []int
+	if !finishesWithin(hangLimit, func() { got = processAll([]int{1, 2, 3, 4, 5, 6, 7, 8}, 3, double) }) {
+		return fmt.Errorf("ProcessAll did not finish")
+	}
+	if want := []int{2, 4, 6, 8, 10, 12, 14, 16}; !reflect.DeepEqual(got, want) {
+		return fmt.Errorf("ProcessAll([1 2 3 4 5 6 7 8], 3, double) = %v, want %v", got, want)
+	}
+	if most := mostRunning.Load(); most > 3 {
+		return fmt.Errorf("ProcessAll processed %v values at once with 3 workers", most)
+	}
+	return nil
+}
+
+// fan-out-1
+// the order across channels does not matter
+func MergeInts(inputs ...<-chan int) <-chan int {
+	// your code here
+	return nil
+}
+
+func checkMergeInts(mergeInts func(...<-chan int) <-chan int) error {
+	send := func(values ...int) <-chan int {
+		out := make(chan int)
+		go func() {
+			defer close(out)
+			for _, value := range values {
+				out <- value
+			}
+		}()
+		return out
+	}
+	var got []int
+	if !finishesWithin(hangLimit, func() {
+		for value := range mergeInts(send(1, 2), send(), send(3, 4, 5)) {
+			got = append(got, value)
+		}
+	}) {
+		return fmt.Errorf("the channel of MergeInts was not closed")
+	}
+	slices.Sort(got)
+	if !reflect.DeepEqual(got, []int{1, 2, 3, 4, 5}) {
+		return fmt.Errorf("MergeInts sent %v, want [1 2 3 4 5] in any order", got)
+	}
+	return nil
+}
+
+// pipelines-1
+// close the output in both cases
+func SquareUntil(ctx context.Context, in <-chan int) <-chan int {
+	// your code here
+	return nil
+}
+
+func checkSquareUntil(squareUntil func(context.Context, <-chan int) <-chan int) error {
+	in := make(chan int, 3)
+	in <- 1
+	in <- 2
+	in <- 3
+	close(in)
+	var got []int
+	if !finishesWithin(hangLimit, func() {
+		for value := range squareUntil(context.Background(), in) {
+			got = append(got, value)
+		}
+	}) {
+		return fmt.Errorf("the output of SquareUntil was not closed after its input")
+	}
+	if !reflect.DeepEqual(got, []int{1, 4, 9}) {
+		return fmt.Errorf("SquareUntil([1 2 3]) sent %v, want [1 4 9]", got)
+	}
+
+	// an input that never closes
+	ctx, cancel := context.WithCancel(context.Background())
+	out := squareUntil(ctx, make(chan int))
+	cancel()
+	if !finishesWithin(hangLimit, func() {
+		for range out {
+		}
+	}) {
+		return fmt.Errorf("the output of SquareUntil was not closed after the context was canceled")
+	}
+	return nil
+}
+
+// sync-1
+// the mutex guards the map
+type SafeCounter struct {
+	mutex  sync.Mutex
+	counts map[string]int
+}
+
+func NewSafeCounter() *SafeCounter {
+	return &SafeCounter{counts: make(map[string]int)}
+}
+
+func (c *SafeCounter) Inc(key string) {
+	// your code here
+}
+
+func (c *SafeCounter) Value(key string) int {
+	// your code here
+	return 0
+}
+
+func checkSafeCounter(newCounter func() (func(string), func(string) int)) error {
+	inc, value := newCounter()
+	var finished sync.WaitGroup
+	for i := range 100 {
+		finished.Add(1)
+		go func() {
+			defer finished.Done()
+			inc("all")
+			if i%2 == 0 {
+				inc("even")
+			}
+		}()
+	}
+	finished.Wait()
+	if all, even := value("all"), value("even"); all != 100 || even != 50 {
+		return fmt.Errorf("counted all: %v, even: %v, want 100 and 50", all, even)
+	}
+	return nil
+}
+
+// atomics-1
+// each goroutine adds 1 increments times
+func CountConcurrently(goroutines, increments int) int64 {
+	// your code here
+	return 0
+}
+
+func checkCountConcurrently(countConcurrently func(int, int) int64) error {
+	if got := countConcurrently(8, 1000); got != 8000 {
+		return fmt.Errorf("CountConcurrently(8, 1000) = %v, want 8000", got)
+	}
+	return nil
+}
+
+// cond-1
+// Wait blocks until Open is called
+// and returns right away after
+type Gate struct {
+	mutex sync.Mutex
+	cond  *sync.Cond
+	open  bool
+}
+
+func NewGate() *Gate {
+	gate := &Gate{}
+	gate.cond = sync.NewCond(&gate.mutex)
+	return gate
+}
+
+func (g *Gate) Wait() {
+	// your code here
+}
+
+func (g *Gate) Open() {
+	// your code here
+}
+
+func checkGate(newGate func() (func(), func())) error {
+	wait, open := newGate()
+	var opened atomic.Bool
+	var early atomic.Int32
+	var finished sync.WaitGroup
+	for range 3 {
+		finished.Add(1)
+		go func() {
+			defer finished.Done()
+			wait()
+			if !opened.Load() {
+				early.Add(1)
+			}
+		}()
+	}
+
+	// giving the waiters time
+	// to return too early
+	time.Sleep(20 * time.Millisecond)
+	opened.Store(true)
+	open()
+	if !finishesWithin(hangLimit, finished.Wait) {
+		return fmt.Errorf("waiters were still blocked after Open")
+	}
+	if count := early.Load(); count > 0 {
+		return fmt.Errorf("%v waiters returned before Open", count)
+	}
+	if !finishesWithin(hangLimit, wait) {
+		return fmt.Errorf("Wait blocked after Open")
+	}
+	return nil
+}
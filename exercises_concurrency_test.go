@@ -0,0 +1,299 @@
+package main
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+type gateSolution struct {
+	mutex sync.Mutex
+	cond  *sync.Cond
+	open  bool
+}
+
+func (g *gateSolution) Wait() {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	for !g.open {
+		g.cond.Wait()
+	}
+}
+
+func (g *gateSolution) Open() {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	g.open = true
+	g.cond.Broadcast()
+}
+
+// Signal wakes a single waiter
+// the others stay blocked
+type signalingGate struct {
+	gateSolution
+}
+
+func (g *signalingGate) Open() {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	g.open = true
+	g.cond.Signal()
+}
+
+func TestConcurrencyExerciseCheckers(t *testing.T) {
+	testCheckers(t, []checkerTest{
+		{
+			"goroutines-1",
+			func() error {
+				return checkGo(func(task func()) <-chan struct{} {
+					done := make(chan struct{})
+					go func() {
+						defer close(done)
+						task()
+					}()
+					return done
+				})
+			},
+			func() error {
+				return checkGo(func(task func()) <-chan struct{} {
+					go task()
+					return make(chan struct{})
+				})
+			},
+		},
+		{
+			"waitgroups-1",
+			func() error {
+				return checkRunAll(func(tasks []func()) {
+					var wg sync.WaitGroup
+					for _, task := range tasks {
+						wg.Add(1)
+						go func() {
+							defer wg.Done()
+							task()
+						}()
+					}
+					wg.Wait()
+				})
+			},
+			func() error {
+				return checkRunAll(func(tasks []func()) {
+					for _, task := range tasks {
+						go task()
+					}
+				})
+			},
+		},
+		{
+			"channels-1",
+			func() error {
+				return checkGenerate(func(n int) <-chan int {
+					out := make(chan int)
+					go func() {
+						defer close(out)
+						for i := 1; i <= n; i++ {
+							out <- i
+						}
+					}()
+					return out
+				})
+			},
+			func() error {
+				return checkGenerate(func(n int) <-chan int {
+					out := make(chan int, n)
+					for i := range n {
+						out <- i
+					}
+					close(out)
+					return out
+				})
+			},
+		},
+		{
+			"loopvar-1",
+			func() error {
+				return checkClosures(func(n int) []func() int {
+					var funcs []func() int
+					for i := range n {
+						funcs = append(funcs, func() int { return i })
+					}
+					return funcs
+				})
+			},
+			func() error {
+				return checkClosures(func(n int) []func() int {
+					var funcs []func() int
+					i := 0
+					for ; i < n; i++ {
+						funcs = append(funcs, func() int { return i })
+					}
+					return funcs
+				})
+			},
+		},
+		{
+			"select-1",
+			func() error {
+				return checkReceiveOrDefault(func(values <-chan int, fallback int) int {
+					select {
+					case value := <-values:
+						return value
+					default:
+						return fallback
+					}
+				})
+			},
+			func() error {
+				return checkReceiveOrDefault(func(values <-chan int, fallback int) int { return fallback })
+			},
+		},
+		{
+			"workerpools-1",
+			func() error {
+				return checkProcessAll(func(values []int, workers int, process func(int) int) []int {
+					results := make([]int, len(values))
+					indexes := make(chan int)
+					var wg sync.WaitGroup
+					for range workers {
+						wg.Add(1)
+						go func() {
+							defer wg.Done()
+							for i := range indexes {
+								results[i] = process(values[i])
+							}
+						}()
+					}
+					for i := range values {
+						indexes <- i
+					}
+					close(indexes)
+					wg.Wait()
+					return results
+				})
+			},
+			func() error {
+				return checkProcessAll(func(values []int, workers int, process func(int) int) []int {
+					var results []int
+					for _, value := range slices.Backward(values) {
+						results = append(results, process(value))
+					}
+					return results
+				})
+			},
+		},
+		{
+			"fan-out-1",
+			func() error { return checkMergeInts(Merge[int]) },
+			func() error {
+				return checkMergeInts(func(inputs ...<-chan int) <-chan int { return inputs[0] })
+			},
+		},
+		{
+			"pipelines-1",
+			func() error {
+				return checkSquareUntil(func(ctx context.Context, in <-chan int) <-chan int {
+					out := make(chan int)
+					go func() {
+						defer close(out)
+						for {
+							select {
+							case value, ok := <-in:
+								if !ok {
+									return
+								}
+								select {
+								case out <- value * value:
+								case <-ctx.Done():
+									return
+								}
+							case <-ctx.Done():
+								return
+							}
+						}
+					}()
+					return out
+				})
+			},
+			func() error {
+				return checkSquareUntil(func(ctx context.Context, in <-chan int) <-chan int {
+					out := make(chan int)
+					go func() {
+						defer close(out)
+						for value := range in {
+							out <- value * value
+						}
+					}()
+					return out
+				})
+			},
+		},
+		{
+			"sync-1",
+			func() error {
+				return checkSafeCounter(func() (func(string), func(string) int) {
+					var mutex sync.Mutex
+					counts := make(map[string]int)
+					inc := func(key string) {
+						mutex.Lock()
+						defer mutex.Unlock()
+						counts[key]++
+					}
+					value := func(key string) int {
+						mutex.Lock()
+						defer mutex.Unlock()
+						return counts[key]
+					}
+					return inc, value
+				})
+			},
+			func() error {
+				return checkSafeCounter(func() (func(string), func(string) int) {
+					var total atomic.Int32
+					return func(string) { total.Add(1) }, func(string) int { return int(total.Load()) }
+				})
+			},
+		},
+		{
+			"atomics-1",
+			func() error {
+				return checkCountConcurrently(func(goroutines, increments int) int64 {
+					var count atomic.Int64
+					runTimes(goroutines, func() {
+						for range increments {
+							count.Add(1)
+						}
+					})
+					return count.Load()
+				})
+			},
+			func() error {
+				return checkCountConcurrently(func(goroutines, increments int) int64 {
+					var count atomic.Int64
+					runTimes(goroutines, func() {
+						count.Store(int64(increments))
+					})
+					return count.Load()
+				})
+			},
+		},
+		{
+			"cond-1",
+			func() error {
+				return checkGate(func() (func(), func()) {
+					gate := &gateSolution{}
+					gate.cond = sync.NewCond(&gate.mutex)
+					return gate.Wait, gate.Open
+				})
+			},
+			func() error {
+				return checkGate(func() (func(), func()) {
+					gate := &signalingGate{}
+					gate.cond = sync.NewCond(&gate.mutex)
+					return gate.Wait, gate.Open
+				})
+			},
+		},
+	})
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+func init() {
+	RegisterExercise(Exercise{"cancellation-1", "cancellation", "send numbers until the context is canceled",
+		func() error { return checkProduce(Produce) }})
+	RegisterExercise(Exercise{"timeouts-1", "timeouts", "call a fetch with a deadline",
+		func() error { return checkFetchWithTimeout(FetchWithTimeout) }})
+	RegisterExercise(Exercise{"values-1", "values", "carry a user name in a context",
+		func() error { return checkUserContext(WithUser, UserFrom) }})
+	RegisterExercise(Exercise{"errgroups-1", "errgroups", "run checks concurrently stopping the others on the first error",
+		func() error { return checkCheckAll(CheckAll) }})
+}
+
+// cancellation-1
+// send 1, 2, 3 and so on
+// return ctx.Err() once ctx is done
+func Produce(ctx context.Context, out chan<- int) error {
+	// your code here
+	return nil
+}
+
+func checkProduce(produce func(context.Context, chan<- int) error) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	out := make(chan int)
+	var err error
+	finished := make(chan struct{})
+	go func() {
+		defer close(finished)
+		err = produce(ctx, out)
+	}()
+	for want := 1; want <= 3; want++ {
+		select {
+		case got := <-out:
+			if got != want {
+				return fmt.Errorf("Produce sent %v, want %v", got, want)
+			}
+		case <-finished:
+			return fmt.Errorf("Produce returned %v before being canceled", err)
+		case <-time.After(hangLimit):
+			return fmt.Errorf("Produce did not send %v", want)
+		}
+	}
+	cancel()
+	if !finishesWithin(hangLimit, func() { <-finished }) {
+		return fmt.Errorf("Produce kept going after being canceled")
+	}
+	if !errors.Is(err, context.Canceled) {
+		return fmt.Errorf("Produce returned %v, want context.Canceled", err)
+	}
+	return nil
+}
+
+// timeouts-1
+// call cancel to release the timer
+func FetchWithTimeout(fetch func(context.Context) (string, error), limit time.Duration) (string, error) {
+	// your code here
+	return fetch(context.Background())
+}
+
+func checkFetchWithTimeout(fetchWithTimeout func(func(context.Context) (string, error), time.Duration) (string, error)) error {
+	quick := func(ctx context.Context) (string, error) {
+		if _, ok := ctx.Deadline(); !ok {
+			return "", errors.New("no deadline")
+		}
+		return "found", nil
+	}
+	if got, err := fetchWithTimeout(quick, hangLimit); got != "found" || err != nil {
+		return fmt.Errorf("FetchWithTimeout(quick) = %q, %v, want found, nil", got, err)
+	}
+	slow := func(ctx context.Context) (string, error) {
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case <-time.After(hangLimit):
+			return "", errors.New("the deadline never came")
+		}
+	}
+	if _, err := fetchWithTimeout(slow, 10*time.Millisecond); !errors.Is(err, context.DeadlineExceeded) {
+		return fmt.Errorf("FetchWithTimeout(slow) returned %v, want context.DeadlineExceeded", err)
+	}
+	return nil
+}
+
+// values-1
+// a key of an unexported type
+// cannot collide with other packages
+type userKey struct{}
+
+func WithUser(ctx context.Context, name string) context.Context {
+	// your code here
+	return ctx
+}
+
+// false when no user was set
+func UserFrom(ctx context.Context) (string, bool) {
+	// your code here
+	return "", false
+}
+
+func checkUserContext(withUser func(context.Context, string) context.Context, userFrom func(context.Context) (string, bool)) error {
+	if name, ok := userFrom(context.Background()); ok {
+		return fmt.Errorf("UserFrom(Background) = %q, true, want false", name)
+	}
+	ctx := withUser(context.Background(), "alice")
+	ctx = context.WithValue(ctx, "user", "mallory")
+	if name, ok := userFrom(ctx); name != "alice" || !ok {
+		return fmt.Errorf("UserFrom = %q, %v, want alice, true", name, ok)
+	}
+	return nil
+}
+
+// errgroups-1
+// errgroup.WithContext cancels its context
+// when the first check fails
+func CheckAll(ctx context.Context, checks []func(context.Context) error) error {
+	// your code here
+	return nil
+}
+
+func checkCheckAll(checkAll func(context.Context, []func(context.Context) error) error) error {
+	ok := func(context.Context) error { return nil }
+	if err := checkAll(context.Background(), []func(context.Context) error{ok, ok}); err != nil {
+		return fmt.Errorf("CheckAll(ok, ok) = %v, want nil", err)
+	}
+	errBroken := errors.New("broken")
+	broken := func(context.Context) error { return errBroken }
+	waiting := func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	var err error
+	if !finishesWithin(hangLimit, func() {
+		err = checkAll(context.Background(), []func(context.Context) error{waiting, broken, waiting})
+	}) {
+		return fmt.Errorf("CheckAll did not cancel the other checks after a failure")
+	}
+	if !errors.Is(err, errBroken) {
+		return fmt.Errorf("CheckAll(waiting, broken, waiting) = %v, want broken", err)
+	}
+	return nil
+}
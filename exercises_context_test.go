@@ -0,0 +1,102 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+)
+
+type userKeySolution struct{}
+
+func TestContextExerciseCheckers(t *testing.T) {
+	testCheckers(t, []checkerTest{
+		{
+			"cancellation-1",
+			func() error {
+				return checkProduce(func(ctx context.Context, out chan<- int) error {
+					for value := 1; ; value++ {
+						select {
+						case out <- value:
+						case <-ctx.Done():
+							return ctx.Err()
+						}
+					}
+				})
+			},
+			func() error {
+				return checkProduce(func(ctx context.Context, out chan<- int) error {
+					for value := 1; ; value++ {
+						select {
+						case out <- value:
+						case <-ctx.Done():
+							return nil
+						}
+					}
+				})
+			},
+		},
+		{
+			"timeouts-1",
+			func() error {
+				return checkFetchWithTimeout(func(fetch func(context.Context) (string, error), limit time.Duration) (string, error) {
+					ctx, cancel := context.WithTimeout(context.Background(), limit)
+					defer cancel()
+					return fetch(ctx)
+				})
+			},
+			func() error {
+				return checkFetchWithTimeout(func(fetch func(context.Context) (string, error), limit time.Duration) (string, error) {
+					ctx, cancel := context.WithCancel(context.Background())
+					defer cancel()
+					return fetch(ctx)
+				})
+			},
+		},
+		{
+			"values-1",
+			func() error {
+				return checkUserContext(
+					func(ctx context.Context, name string) context.Context {
+						return context.WithValue(ctx, userKeySolution{}, name)
+					},
+					func(ctx context.Context) (string, bool) {
+						name, ok := ctx.Value(userKeySolution{}).(string)
+						return name, ok
+					})
+			},
+			func() error {
+				return checkUserContext(
+					func(ctx context.Context, name string) context.Context {
+						return context.WithValue(ctx, "user", name)
+					},
+					func(ctx context.Context) (string, bool) {
+						name, ok := ctx.Value("user").(string)
+						return name, ok
+					})
+			},
+		},
+		{
+			"errgroups-1",
+			func() error {
+				return checkCheckAll(func(ctx context.Context, checks []func(context.Context) error) error {
+					group, groupContext := errgroup.WithContext(ctx)
+					for _, check := range checks {
+						group.Go(func() error { return check(groupContext) })
+					}
+					return group.Wait()
+				})
+			},
+			func() error {
+				return checkCheckAll(func(ctx context.Context, checks []func(context.Context) error) error {
+					var group errgroup.Group
+					for _, check := range checks {
+						group.Go(func() error { return check(ctx) })
+					}
+					return group.Wait()
+				})
+			},
+		},
+	})
+}
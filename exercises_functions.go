@@ -0,0 +1,210 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"reflect"
+	"strconv"
+	"testing/fstest"
+)
+
+func init() {
+	RegisterExercise(Exercise{"functions-1", "functions", "return a closure counting how many times it was called",
+		func() error { return checkCounter(Counter) }})
+	RegisterExercise(Exercise{"panics-1", "panics", "index a slice turning the out of range panic into an error",
+		func() error { return checkSafeIndex(SafeIndex) }})
+	RegisterExercise(Exercise{"errors-1", "errors", "parse every quantity and join the errors of the bad ones",
+		func() error { return checkParseAll(ParseAll) }})
+	RegisterExercise(Exercise{"wrapping-1", "wrapping", "read a config file wrapping the error with its name",
+		func() error { return checkReadConfig(ReadConfig) }})
+	RegisterExercise(Exercise{"custom-errors-1", "custom-errors", "reject an impossible age with an AgeError",
+		func() error { return checkValidateAge(ValidateAge) }})
+	RegisterExercise(Exercise{"sentinels-1", "sentinels", "check the stock of an item returning a sentinel error",
+		func() error { return checkCheckStock(CheckStock) }})
+	RegisterExercise(Exercise{"files-1", "files", "return the longest line of a file",
+		func() error { return checkLongestLine(LongestLine) }})
+}
+
+// functions-1
+// each counter has its own count
+func Counter() func() int {
+	// your code here
+	return func() int { return 0 }
+}
+
+func checkCounter(counter func() func() int) error {
+	first, second := counter(), counter()
+	for want := 1; want <= 3; want++ {
+		if got := first(); got != want {
+			return fmt.Errorf("call %v of a counter returned %v, want %v", want, got, want)
+		}
+	}
+	if got := second(); got != 1 {
+		return fmt.Errorf("the first call of a second counter returned %v, want 1", got)
+	}
+	return nil
+}
+
+// panics-1
+// a deferred function can change
+// the named results after a recover
+func SafeIndex(values []int, index int) (value int, err error) {
+	// your code here
+	return 0, nil
+}
+
+func checkSafeIndex(safeIndex func([]int, int) (int, error)) error {
+	values := []int{10, 20, 30}
+	if value, err := safeIndex(values, 1); value != 20 || err != nil {
+		return fmt.Errorf("SafeIndex(%v, 1) = %v, %v, want 20, nil", values, value, err)
+	}
+	for _, index := range []int{3, -1} {
+		if _, err := safeIndex(values, index); err == nil {
+			return fmt.Errorf("SafeIndex(%v, %v) returned no error", values, index)
+		}
+	}
+	return nil
+}
+
+// errors-1
+// errors.Join skips the nil errors
+// and returns nil when they all are
+func ParseAll(inputs []string) ([]int, error) {
+	// your code here
+	return nil, nil
+}
+
+func checkParseAll(parseAll func([]string) ([]int, error)) error {
+	if values, err := parseAll([]string{"1", "2"}); !reflect.DeepEqual(values, []int{1, 2}) || err != nil {
+		return fmt.Errorf("ParseAll([1 2]) = %v, %v, want [1 2], nil", values, err)
+	}
+	values, err := parseAll([]string{"1", "two", "3", "four"})
+	if !reflect.DeepEqual(values, []int{1, 3}) {
+		return fmt.Errorf("ParseAll([1 two 3 four]) = %v, want [1 3]", values)
+	}
+	joined, ok := err.(interface{ Unwrap() []error })
+	if !ok || len(joined.Unwrap()) != 2 || !errors.Is(err, strconv.ErrSyntax) {
+		return fmt.Errorf("ParseAll([1 two 3 four]) returned %v, want the two syntax errors joined", err)
+	}
+	return nil
+}
+
+// wrapping-1
+// %w keeps the error of fs.ReadFile
+// reachable by errors.Is
+func ReadConfig(fsys fs.FS, name string) ([]byte, error) {
+	// your code here
+	return nil, nil
+}
+
+func checkReadConfig(readConfig func(fs.FS, string) ([]byte, error)) error {
+	fsys := fstest.MapFS{"app.conf": {Data: []byte("port=8080")}}
+	if data, err := readConfig(fsys, "app.conf"); string(data) != "port=8080" || err != nil {
+		return fmt.Errorf("ReadConfig(app.conf) = %q, %v, want %q, nil", data, err, "port=8080")
+	}
+	_, err := readConfig(fsys, "missing.conf")
+	if !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("ReadConfig(missing.conf) returned %v, want an error wrapping fs.ErrNotExist", err)
+	}
+	if _, wrapped := err.(interface{ Unwrap() error }); !wrapped {
+		return fmt.Errorf("ReadConfig(missing.conf) returned %v, want it wrapped with some context", err)
+	}
+	return nil
+}
+
+// custom-errors-1
+// an age is between 0 and 150
+type AgeError struct {
+	Age int
+}
+
+func (e *AgeError) Error() string {
+	return fmt.Sprintf("impossible age %v", e.Age)
+}
+
+// return a nil error, not a nil *AgeError
+func ValidateAge(age int) error {
+	// your code here
+	return nil
+}
+
+func checkValidateAge(validateAge func(int) error) error {
+	for _, age := range []int{0, 42, 150} {
+		if err := validateAge(age); err != nil {
+			return fmt.Errorf("ValidateAge(%v) = %v, want nil", age, err)
+		}
+	}
+	for _, age := range []int{-1, 151} {
+		var ageError *AgeError
+		if err := validateAge(age); !errors.As(err, &ageError) || ageError == nil || ageError.Age != age {
+			return fmt.Errorf("ValidateAge(%v) = %v, want an *AgeError for %v", age, err, age)
+		}
+	}
+	return nil
+}
+
+// sentinels-1
+var ErrUnknownItem = errors.New("unknown item")
+var ErrOutOfStock = errors.New("out of stock")
+
+// wrap the sentinel errors
+// to say which item it was about
+func CheckStock(stock map[string]int, item string, quantity int) error {
+	// your code here
+	return nil
+}
+
+func checkCheckStock(checkStock func(map[string]int, string, int) error) error {
+	stock := map[string]int{"flour": 3, "sugar": 0}
+	var tests = []struct {
+		item     string
+		quantity int
+		want     error
+	}{
+		{"flour", 3, nil},
+		{"flour", 4, ErrOutOfStock},
+		{"sugar", 1, ErrOutOfStock},
+		{"salt", 1, ErrUnknownItem},
+	}
+	for _, test := range tests {
+		err := checkStock(stock, test.item, test.quantity)
+		if (test.want == nil && err != nil) || !errors.Is(err, test.want) {
+			return fmt.Errorf("CheckStock(%v, %v, %v) = %v, want %v", stock, test.item, test.quantity, err, test.want)
+		}
+		if test.want != nil && err == test.want {
+			return fmt.Errorf("CheckStock(%v, %v, %v) = %v, want it wrapped with the item", stock, test.item, test.quantity, err)
+		}
+	}
+	return nil
+}
+
+// files-1
+// the first one wins a tie
+func LongestLine(path string) (string, error) {
+	// your code here
+	return "", nil
+}
+
+func checkLongestLine(longestLine func(string) (string, error)) error {
+	file, err := os.CreateTemp("", "longest-*.txt")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+	_, err = file.WriteString("one\nthree\nfive\nseven\n")
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	if line, err := longestLine(file.Name()); line != "three" || err != nil {
+		return fmt.Errorf("LongestLine(one three five seven) = %q, %v, want %q, nil", line, err, "three")
+	}
+	if _, err := longestLine(file.Name() + ".missing"); !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("LongestLine of a missing file returned %v, want an error wrapping fs.ErrNotExist", err)
+	}
+	return nil
+}
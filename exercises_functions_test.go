@@ -0,0 +1,190 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"strconv"
+	"testing"
+)
+
+func TestFunctionsExerciseCheckers(t *testing.T) {
+	testCheckers(t, []checkerTest{
+		{
+			"functions-1",
+			func() error {
+				return checkCounter(func() func() int {
+					count := 0
+					return func() int {
+						count++
+						return count
+					}
+				})
+			},
+			func() error {
+				count := 0
+				return checkCounter(func() func() int {
+					return func() int {
+						count++
+						return count
+					}
+				})
+			},
+		},
+		{
+			"panics-1",
+			func() error {
+				return checkSafeIndex(func(values []int, index int) (value int, err error) {
+					defer func() {
+						if recovered := recover(); recovered != nil {
+							err = fmt.Errorf("%v", recovered)
+						}
+					}()
+					return values[index], nil
+				})
+			},
+			func() error {
+				return checkSafeIndex(func(values []int, index int) (int, error) {
+					defer func() {
+						recover()
+					}()
+					return values[index], nil
+				})
+			},
+		},
+		{
+			"errors-1",
+			func() error {
+				return checkParseAll(func(inputs []string) ([]int, error) {
+					var values []int
+					var errs []error
+					for _, input := range inputs {
+						value, err := strconv.Atoi(input)
+						if err != nil {
+							errs = append(errs, err)
+							continue
+						}
+						values = append(values, value)
+					}
+					return values, errors.Join(errs...)
+				})
+			},
+			func() error {
+				return checkParseAll(func(inputs []string) ([]int, error) {
+					var values []int
+					for _, input := range inputs {
+						value, err := strconv.Atoi(input)
+						if err != nil {
+							return values, err
+						}
+						values = append(values, value)
+					}
+					return values, nil
+				})
+			},
+		},
+		{
+			"wrapping-1",
+			func() error {
+				return checkReadConfig(func(fsys fs.FS, name string) ([]byte, error) {
+					data, err := fs.ReadFile(fsys, name)
+					if err != nil {
+						return nil, fmt.Errorf("reading config %v: %w", name, err)
+					}
+					return data, nil
+				})
+			},
+			func() error {
+				return checkReadConfig(func(fsys fs.FS, name string) ([]byte, error) {
+					data, err := fs.ReadFile(fsys, name)
+					if err != nil {
+						return nil, fmt.Errorf("reading config %v: %v", name, err)
+					}
+					return data, nil
+				})
+			},
+		},
+		{
+			"custom-errors-1",
+			func() error {
+				return checkValidateAge(func(age int) error {
+					if age < 0 || age > 150 {
+						return &AgeError{age}
+					}
+					return nil
+				})
+			},
+			func() error {
+				return checkValidateAge(func(age int) error {
+					var err *AgeError
+					if age < 0 || age > 150 {
+						err = &AgeError{age}
+					}
+					return err
+				})
+			},
+		},
+		{
+			"sentinels-1",
+			func() error {
+				return checkCheckStock(func(stock map[string]int, item string, quantity int) error {
+					available, ok := stock[item]
+					if !ok {
+						return fmt.Errorf("%v: %w", item, ErrUnknownItem)
+					}
+					if available < quantity {
+						return fmt.Errorf("%v: %w", item, ErrOutOfStock)
+					}
+					return nil
+				})
+			},
+			func() error {
+				return checkCheckStock(func(stock map[string]int, item string, quantity int) error {
+					if stock[item] < quantity {
+						return fmt.Errorf("%v: %w", item, ErrOutOfStock)
+					}
+					return nil
+				})
+			},
+		},
+		{
+			"files-1",
+			func() error {
+				return checkLongestLine(func(path string) (string, error) {
+					file, err := os.Open(path)
+					if err != nil {
+						return "", err
+					}
+					defer file.Close()
+					longest := ""
+					scanner := bufio.NewScanner(file)
+					for scanner.Scan() {
+						if len(scanner.Text()) > len(longest) {
+							longest = scanner.Text()
+						}
+					}
+					return longest, scanner.Err()
+				})
+			},
+			func() error {
+				return checkLongestLine(func(path string) (string, error) {
+					file, err := os.Open(path)
+					if err != nil {
+						return "", err
+					}
+					defer file.Close()
+					longest := ""
+					scanner := bufio.NewScanner(file)
+					for scanner.Scan() {
+						if len(scanner.Text()) >= len(longest) {
+							longest = scanner.Text()
+						}
+					}
+					return longest, scanner.Err()
+				})
+			},
+		},
+	})
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"math"
+	"reflect"
+)
+
+func init() {
+	RegisterExercise(Exercise{"generics-1", "generics", "find the largest value of any ordered type",
+		func() error { return checkLargest(Largest[int], Largest[string]) }})
+	RegisterExercise(Exercise{"constraints-1", "constraints", "average numbers of any type built on a number",
+		func() error { return checkMean(Mean[int], Mean[Celsius]) }})
+	RegisterExercise(Exercise{"containers-1", "containers", "keep the values found in both slices",
+		func() error { return checkIntersect(Intersect[string]) }})
+	RegisterExercise(Exercise{"functional-1", "functional", "split values into the kept and the dropped ones",
+		func() error { return checkPartition(Partition[int]) }})
+	RegisterExercise(Exercise{"iterators-1", "iterators", "yield the even numbers below a limit",
+		func() error { return checkEvens(Evens) }})
+}
+
+// generics-1
+// false for an empty slice
+func Largest[T cmp.Ordered](values []T) (T, bool) {
+	// your code here
+	var zero T
+	return zero, false
+}
+
+func checkLargest(largestInt func([]int) (int, bool), largestString func([]string) (string, bool)) error {
+	if largest, ok := largestInt([]int{3, -1, 7, 2}); largest != 7 || !ok {
+		return fmt.Errorf("Largest([3 -1 7 2]) = %v, %v, want 7, true", largest, ok)
+	}
+	if largest, ok := largestInt([]int{-5, -3}); largest != -3 || !ok {
+		return fmt.Errorf("Largest([-5 -3]) = %v, %v, want -3, true", largest, ok)
+	}
+	if largest, ok := largestString([]string{"fig", "plum", "apple"}); largest != "plum" || !ok {
+		return fmt.Errorf("Largest([fig plum apple]) = %v, %v, want plum, true", largest, ok)
+	}
+	if _, ok := largestInt(nil); ok {
+		return fmt.Errorf("Largest([]) returned true")
+	}
+	return nil
+}
+
+// constraints-1
+// the Number constraint accepts
+// the types whose underlying type is a number
+// 0 for an empty slice
+func Mean[T Number](values []T) float64 {
+	// your code here
+	return 0
+}
+
+func checkMean(meanInt func([]int) float64, meanCelsius func([]Celsius) float64) error {
+	if got := meanInt([]int{1, 2, 3, 4}); got != 2.5 {
+		return fmt.Errorf("Mean([1 2 3 4]) = %v, want 2.5", got)
+	}
+	if got := meanCelsius([]Celsius{20.5, 22.5, 18.5}); math.Abs(got-20.5) > 1e-9 {
+		return fmt.Errorf("Mean([20.5 22.5 18.5]) = %v, want 20.5", got)
+	}
+	if got := meanInt(nil); got != 0 {
+		return fmt.Errorf("Mean([]) = %v, want 0", got)
+	}
+	return nil
+}
+
+// containers-1
+// in the order of the first slice
+// without duplicates
+func Intersect[T comparable](first, second []T) []T {
+	// your code here
+	return nil
+}
+
+func checkIntersect(intersect func([]string, []string) []string) error {
+	var tests = []struct {
+		first, second []string
+		want          []string
+	}{
+		{[]string{"a", "b"}, []string{"c"}, []string{}},
+		{[]string{"d", "a", "b", "a"}, []string{"a", "d", "e"}, []string{"d", "a"}},
+	}
+	for _, test := range tests {
+		if got := intersect(test.first, test.second); fmt.Sprint(got) != fmt.Sprint(test.want) {
+			return fmt.Errorf("Intersect(%v, %v) = %v, want %v", test.first, test.second, got, test.want)
+		}
+	}
+	return nil
+}
+
+// functional-1
+// both keep the order of values
+func Partition[T any](values []T, keep func(T) bool) (kept, dropped []T) {
+	// your code here
+	return values, nil
+}
+
+func checkPartition(partition func([]int, func(int) bool) ([]int, []int)) error {
+	isEven := func(value int) bool { return value%2 == 0 }
+	kept, dropped := partition([]int{1, 2, 3, 4, 5, 6}, isEven)
+	if !reflect.DeepEqual(kept, []int{2, 4, 6}) || !reflect.DeepEqual(dropped, []int{1, 3, 5}) {
+		return fmt.Errorf("Partition([1 2 3 4 5 6], isEven) = %v, %v, want [2 4 6], [1 3 5]", kept, dropped)
+	}
+	return nil
+}
+
+// iterators-1
+// stop as soon as yield returns false
+func Evens(limit int) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		// your code here
+	}
+}
+
+func checkEvens(evens func(int) iter.Seq[int]) error {
+	var got []int
+	for even := range evens(9) {
+		got = append(got, even)
+	}
+	if !reflect.DeepEqual(got, []int{0, 2, 4, 6, 8}) {
+		return fmt.Errorf("Evens(9) yielded %v, want [0 2 4 6 8]", got)
+	}
+
+	// a range loop that breaks early
+	// panics if the iterator keeps yielding
+	got = nil
+	for even := range evens(100) {
+		if even > 4 {
+			break
+		}
+		got = append(got, even)
+	}
+	if !reflect.DeepEqual(got, []int{0, 2, 4}) {
+		return fmt.Errorf("Evens(100) until 4 yielded %v, want [0 2 4]", got)
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"iter"
+	"slices"
+	"testing"
+)
+
+func largestSolution[T int | string](values []T) (T, bool) {
+	if len(values) == 0 {
+		var zero T
+		return zero, false
+	}
+	return slices.Max(values), true
+}
+
+func firstAsLargest[T int | string](values []T) (T, bool) {
+	if len(values) == 0 {
+		var zero T
+		return zero, false
+	}
+	return values[0], true
+}
+
+func meanSolution[T Number](values []T) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	return float64(Sum(values...)) / float64(len(values))
+}
+
+// dividing before converting
+// truncates the mean of integers
+func truncatedMean[T Number](values []T) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	return float64(Sum(values...) / T(len(values)))
+}
+
+func TestGenericsExerciseCheckers(t *testing.T) {
+	testCheckers(t, []checkerTest{
+		{
+			"generics-1",
+			func() error { return checkLargest(largestSolution[int], largestSolution[string]) },
+			func() error { return checkLargest(firstAsLargest[int], firstAsLargest[string]) },
+		},
+		{
+			"constraints-1",
+			func() error { return checkMean(meanSolution[int], meanSolution[Celsius]) },
+			func() error { return checkMean(truncatedMean[int], truncatedMean[Celsius]) },
+		},
+		{
+			"containers-1",
+			func() error {
+				return checkIntersect(func(first, second []string) []string {
+					inSecond := NewSet(second...)
+					seen := NewSet[string]()
+					var both []string
+					for _, value := range first {
+						if inSecond.Has(value) && !seen.Has(value) {
+							seen.Add(value)
+							both = append(both, value)
+						}
+					}
+					return both
+				})
+			},
+			func() error {
+				return checkIntersect(func(first, second []string) []string {
+					var both []string
+					for _, value := range first {
+						if slices.Contains(second, value) {
+							both = append(both, value)
+						}
+					}
+					return both
+				})
+			},
+		},
+		{
+			"functional-1",
+			func() error {
+				return checkPartition(func(values []int, keep func(int) bool) ([]int, []int) {
+					return Filter(values, keep), Filter(values, func(value int) bool { return !keep(value) })
+				})
+			},
+			func() error {
+				return checkPartition(func(values []int, keep func(int) bool) ([]int, []int) {
+					return Filter(values, keep), values
+				})
+			},
+		},
+		{
+			"iterators-1",
+			func() error {
+				return checkEvens(func(limit int) iter.Seq[int] {
+					return func(yield func(int) bool) {
+						for even := 0; even < limit; even += 2 {
+							if !yield(even) {
+								return
+							}
+						}
+					}
+				})
+			},
+			func() error {
+				return checkEvens(func(limit int) iter.Seq[int] {
+					return func(yield func(int) bool) {
+						for even := 2; even < limit; even += 2 {
+							if !yield(even) {
+								return
+							}
+						}
+					}
+				})
+			},
+		},
+	})
+}
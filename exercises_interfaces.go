@@ -0,0 +1,148 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/Mathieu-Desrochers/Learning-Go/shapes"
+)
+
+func init() {
+	RegisterExercise(Exercise{"interfaces-1", "interfaces", "add up the areas of any shapes",
+		func() error { return checkTotalArea(TotalArea) }})
+	RegisterExercise(Exercise{"composition-1", "composition", "read everything then write it back in upper case",
+		func() error { return checkShout(Shout) }})
+	RegisterExercise(Exercise{"sorting-1", "sorting", "sort words by length with sort.Interface",
+		func() error { return checkByLength(ByLength.Less) }})
+	RegisterExercise(Exercise{"stringers-1", "stringers", "print an amount of cents as dollars",
+		func() error { return checkMoneyString(Money.String) }})
+	RegisterExercise(Exercise{"assertions-1", "assertions", "describe a value with a type switch",
+		func() error { return checkDescribe(Describe) }})
+}
+
+// interfaces-1
+// any type with Area and Perimeter methods
+// is a shapes.Shape
+func TotalArea(all []shapes.Shape) float64 {
+	// your code here
+	return 0
+}
+
+func checkTotalArea(totalArea func([]shapes.Shape) float64) error {
+	rectangle, err := shapes.NewRectangle(2, 3)
+	if err != nil {
+		return err
+	}
+	var tests = []struct {
+		all  []shapes.Shape
+		want float64
+	}{
+		{nil, 0},
+		{[]shapes.Shape{rectangle}, 6},
+		{[]shapes.Shape{rectangle, shapes.Circle{Radius: 1}, rectangle}, 15.14},
+	}
+	for _, test := range tests {
+		if got := totalArea(test.all); math.Abs(got-test.want) > 1e-9 {
+			return fmt.Errorf("TotalArea(%v) = %v, want %v", test.all, got, test.want)
+		}
+	}
+	return nil
+}
+
+// composition-1
+// io.ReadWriter is an io.Reader and an io.Writer
+// io.ReadAll reads until io.EOF
+func Shout(rw io.ReadWriter) error {
+	// your code here
+	return nil
+}
+
+func checkShout(shout func(io.ReadWriter) error) error {
+	buffer := bytes.NewBufferString("hello, gopher")
+	if err := shout(buffer); err != nil || buffer.String() != "HELLO, GOPHER" {
+		return fmt.Errorf("Shout(hello, gopher) left %q, %v, want %q, nil", buffer.String(), err, "HELLO, GOPHER")
+	}
+	return nil
+}
+
+// sorting-1
+// shorter words first
+type ByLength []string
+
+func (x ByLength) Len() int      { return len(x) }
+func (x ByLength) Swap(i, j int) { x[i], x[j] = x[j], x[i] }
+
+func (x ByLength) Less(i, j int) bool {
+	// your code here
+	return false
+}
+
+func checkByLength(less func(ByLength, int, int) bool) error {
+	words := []string{"banana", "fig", "apple", "kiwi", "plum"}
+	sort.SliceStable(words, func(i, j int) bool { return less(words, i, j) })
+	if want := []string{"fig", "kiwi", "plum", "apple", "banana"}; strings.Join(words, " ") != strings.Join(want, " ") {
+		return fmt.Errorf("sorting by length gave %v, want %v", words, want)
+	}
+	return nil
+}
+
+// stringers-1
+// an amount of cents
+// printing as $12.05 or -$0.50
+type Money int64
+
+func (m Money) String() string {
+	// your code here
+	return ""
+}
+
+func checkMoneyString(moneyString func(Money) string) error {
+	var tests = []struct {
+		money Money
+		want  string
+	}{
+		{0, "$0.00"},
+		{1205, "$12.05"},
+		{7, "$0.07"},
+		{-50, "-$0.50"},
+		{-123456, "-$1234.56"},
+	}
+	for _, test := range tests {
+		if got := moneyString(test.money); got != test.want {
+			return fmt.Errorf("Money(%d).String() = %q, want %q", int64(test.money), got, test.want)
+		}
+	}
+	return nil
+}
+
+// assertions-1
+// "int 3" for an int, "string go" for a string
+// "stringer 21.5°C" for a fmt.Stringer
+// and "unknown" for anything else
+func Describe(value any) string {
+	// your code here
+	return "unknown"
+}
+
+func checkDescribe(describe func(any) string) error {
+	var tests = []struct {
+		value any
+		want  string
+	}{
+		{3, "int 3"},
+		{"go", "string go"},
+		{Temperature(21.5), "stringer 21.5°C"},
+		{2.5, "unknown"},
+		{nil, "unknown"},
+	}
+	for _, test := range tests {
+		if got := describe(test.value); got != test.want {
+			return fmt.Errorf("Describe(%#v) = %q, want %q", test.value, got, test.want)
+		}
+	}
+	return nil
+}
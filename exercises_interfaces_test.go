@@ -0,0 +1,117 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/Mathieu-Desrochers/Learning-Go/shapes"
+)
+
+func TestInterfacesExerciseCheckers(t *testing.T) {
+	testCheckers(t, []checkerTest{
+		{
+			"interfaces-1",
+			func() error {
+				return checkTotalArea(func(all []shapes.Shape) float64 {
+					total := 0.0
+					for _, shape := range all {
+						total += shape.Area()
+					}
+					return total
+				})
+			},
+			func() error {
+				return checkTotalArea(func(all []shapes.Shape) float64 {
+					total := 0.0
+					for _, shape := range all {
+						total += shape.Perimeter()
+					}
+					return total
+				})
+			},
+		},
+		{
+			"composition-1",
+			func() error {
+				return checkShout(func(rw io.ReadWriter) error {
+					data, err := io.ReadAll(rw)
+					if err != nil {
+						return err
+					}
+					_, err = rw.Write(bytes.ToUpper(data))
+					return err
+				})
+			},
+			func() error {
+				return checkShout(func(rw io.ReadWriter) error {
+					data := make([]byte, 5)
+					n, err := rw.Read(data)
+					if err != nil {
+						return err
+					}
+					_, err = rw.Write(bytes.ToUpper(data[:n]))
+					return err
+				})
+			},
+		},
+		{
+			"sorting-1",
+			func() error {
+				return checkByLength(func(x ByLength, i, j int) bool { return len(x[i]) < len(x[j]) })
+			},
+			func() error {
+				return checkByLength(func(x ByLength, i, j int) bool { return x[i] < x[j] })
+			},
+		},
+		{
+			"stringers-1",
+			func() error {
+				return checkMoneyString(func(m Money) string {
+					sign := ""
+					if m < 0 {
+						sign, m = "-", -m
+					}
+					return fmt.Sprintf("%v$%d.%02d", sign, m/100, m%100)
+				})
+			},
+			func() error {
+				return checkMoneyString(func(m Money) string {
+					return fmt.Sprintf("$%.2f", float64(m)/100)
+				})
+			},
+		},
+		{
+			"assertions-1",
+			func() error {
+				return checkDescribe(func(value any) string {
+					switch value := value.(type) {
+					case int:
+						return fmt.Sprintf("int %v", value)
+					case string:
+						return fmt.Sprintf("string %v", value)
+					case fmt.Stringer:
+						return fmt.Sprintf("stringer %v", value)
+					default:
+						return "unknown"
+					}
+				})
+			},
+			func() error {
+				return checkDescribe(func(value any) string {
+					switch value := value.(type) {
+					case int:
+						return fmt.Sprintf("int %v", value)
+					case string:
+						return fmt.Sprintf("string %v", value)
+					case float64:
+						return fmt.Sprintf("stringer %v", value)
+					default:
+						return "unknown"
+					}
+				})
+			},
+		},
+	})
+}
@@ -0,0 +1,59 @@
+package main
+
+import "fmt"
+
+func init() {
+	RegisterExercise(Exercise{"strings-1", "strings", "reverse a string rune by rune",
+		func() error { return checkReverseRunes(ReverseRunes) }})
+	RegisterExercise(Exercise{"formatting-1", "formatting", "format a receipt line with the item padded to 10 and the price to 8 with 2 decimals",
+		func() error { return checkFormatLine(FormatLine) }})
+}
+
+// strings-1
+func ReverseRunes(s string) string {
+	// your code here
+	return s
+}
+
+func checkReverseRunes(reverseRunes func(string) string) error {
+	var tests = []struct {
+		s    string
+		want string
+	}{
+		{"", ""},
+		{"go", "og"},
+		{"γλώσσα", "ασσώλγ"},
+		{"a€b", "b€a"},
+	}
+	for _, test := range tests {
+		if got := reverseRunes(test.s); got != test.want {
+			return fmt.Errorf("ReverseRunes(%q) = %q, want %q", test.s, got, test.want)
+		}
+	}
+	return nil
+}
+
+// formatting-1
+// the price is given in cents
+func FormatLine(item string, cents int) string {
+	// your code here
+	return item
+}
+
+func checkFormatLine(formatLine func(string, int) string) error {
+	var tests = []struct {
+		item  string
+		cents int
+		want  string
+	}{
+		{"coffee", 350, "coffee        3.50"},
+		{"croissant", 1205, "croissant    12.05"},
+		{"tip", 7, "tip           0.07"},
+	}
+	for _, test := range tests {
+		if got := formatLine(test.item, test.cents); got != test.want {
+			return fmt.Errorf("FormatLine(%q, %v) = %q, want %q", test.item, test.cents, got, test.want)
+		}
+	}
+	return nil
+}
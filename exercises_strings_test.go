@@ -0,0 +1,45 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestStringsExerciseCheckers(t *testing.T) {
+	testCheckers(t, []checkerTest{
+		{
+			"strings-1",
+			func() error {
+				return checkReverseRunes(func(s string) string {
+					runes := []rune(s)
+					for i, j := 0, len(runes)-1; i < j; i, j = i+1, j-1 {
+						runes[i], runes[j] = runes[j], runes[i]
+					}
+					return string(runes)
+				})
+			},
+			func() error {
+				return checkReverseRunes(func(s string) string {
+					bytes := []byte(s)
+					for i, j := 0, len(bytes)-1; i < j; i, j = i+1, j-1 {
+						bytes[i], bytes[j] = bytes[j], bytes[i]
+					}
+					return string(bytes)
+				})
+			},
+		},
+		{
+			"formatting-1",
+			func() error {
+				return checkFormatLine(func(item string, cents int) string {
+					return fmt.Sprintf("%-10v%8.2f", item, float64(cents)/100)
+				})
+			},
+			func() error {
+				return checkFormatLine(func(item string, cents int) string {
+					return fmt.Sprintf("%10v%8.2f", item, float64(cents)/100)
+				})
+			},
+		},
+	})
+}
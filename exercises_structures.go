@@ -0,0 +1,233 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+)
+
+func init() {
+	RegisterExercise(Exercise{"structures-1", "structures", "move a point through a pointer",
+		func() error { return checkMove(Move) }})
+	RegisterExercise(Exercise{"methods-1", "methods", "withdraw from a wallet unless the balance is too low",
+		func() error { return checkWithdraw((*Wallet).Withdraw) }})
+	RegisterExercise(Exercise{"embedding-1", "embedding", "count the bytes going through an embedded writer",
+		func() error {
+			return checkCountingWriter(func(w io.Writer) (io.Writer, func() int) {
+				counting := &CountingWriter{Writer: w}
+				return counting, func() int { return counting.Count }
+			})
+		}})
+	RegisterExercise(Exercise{"tags-1", "tags", "list the column names of a struct from its db tags",
+		func() error { return checkColumnNames(ColumnNames) }})
+	RegisterExercise(Exercise{"options-1", "options", "build a pizza from functional options",
+		func() error { return checkNewPizza(NewPizza, WithSize, WithTopping) }})
+	RegisterExercise(Exercise{"builders-1", "builders", "build a query by chaining calls",
+		func() error {
+			return checkQueryBuilder(func(table string, conditions []string, limit int) string {
+				builder := NewQueryBuilder(table)
+				for _, condition := range conditions {
+					builder = builder.Where(condition)
+				}
+				if limit > 0 {
+					builder = builder.Limit(limit)
+				}
+				return builder.Build()
+			})
+		}})
+	RegisterExercise(Exercise{"equality-1", "equality", "count the distinct points",
+		func() error { return checkCountDistinct(CountDistinct) }})
+}
+
+// structures-1
+func Move(point *Point, dx, dy int) {
+	// your code here
+}
+
+func checkMove(move func(*Point, int, int)) error {
+	point := Point{1, 2}
+	move(&point, 3, -4)
+	if point != (Point{4, -2}) {
+		return fmt.Errorf("Move({1 2}, 3, -4) left %v, want {4 -2}", point)
+	}
+	return nil
+}
+
+// methods-1
+type Wallet struct {
+	Balance int
+}
+
+// a value receiver would withdraw from a copy
+func (w *Wallet) Withdraw(amount int) bool {
+	// your code here
+	return true
+}
+
+func checkWithdraw(withdraw func(*Wallet, int) bool) error {
+	wallet := &Wallet{10}
+	if !withdraw(wallet, 7) || wallet.Balance != 3 {
+		return fmt.Errorf("withdrawing 7 from 10 left %v, want 3", wallet.Balance)
+	}
+	if withdraw(wallet, 4) || wallet.Balance != 3 {
+		return fmt.Errorf("withdrawing 4 from 3 succeeded leaving %v", wallet.Balance)
+	}
+	return nil
+}
+
+// embedding-1
+// the embedded Writer receives the bytes
+// Count adds up how many it wrote
+type CountingWriter struct {
+	io.Writer
+	Count int
+}
+
+// this Write shadows the embedded one
+func (w *CountingWriter) Write(p []byte) (int, error) {
+	// your code here
+	return 0, nil
+}
+
+func checkCountingWriter(newWriter func(io.Writer) (io.Writer, func() int)) error {
+	var buffer bytes.Buffer
+	writer, count := newWriter(&buffer)
+	fmt.Fprint(writer, "hello")
+	fmt.Fprint(writer, ", world")
+	if buffer.String() != "hello, world" || count() != 12 {
+		return fmt.Errorf("writing hello, world wrote %q counting %v, want 12", buffer.String(), count())
+	}
+	return nil
+}
+
+// tags-1
+// the field name is used without a db tag
+// a db:"-" tag leaves the field out
+func ColumnNames(value any) []string {
+	// your code here
+	return nil
+}
+
+func checkColumnNames(columnNames func(any) []string) error {
+	type customer struct {
+		ID       int    `db:"customer_id" json:"id"`
+		Name     string `json:"name"`
+		Password string `db:"-"`
+		Email    string `db:"email"`
+	}
+	want := []string{"customer_id", "Name", "email"}
+	if got := columnNames(customer{}); !reflect.DeepEqual(got, want) {
+		return fmt.Errorf("ColumnNames(customer) = %v, want %v", got, want)
+	}
+	return nil
+}
+
+// options-1
+// a pizza is medium without a size option
+type Pizza struct {
+	Size     string
+	Toppings []string
+}
+
+type PizzaOption func(*Pizza)
+
+func NewPizza(options ...PizzaOption) Pizza {
+	// your code here
+	return Pizza{}
+}
+
+func WithSize(size string) PizzaOption {
+	// your code here
+	return func(*Pizza) {}
+}
+
+func WithTopping(topping string) PizzaOption {
+	// your code here
+	return func(*Pizza) {}
+}
+
+func checkNewPizza(newPizza func(...PizzaOption) Pizza, withSize, withTopping func(string) PizzaOption) error {
+	if pizza := newPizza(); pizza.Size != "medium" || len(pizza.Toppings) != 0 {
+		return fmt.Errorf("NewPizza() = %+v, want a medium pizza without toppings", pizza)
+	}
+	pizza := newPizza(withTopping("olives"), withSize("large"), withTopping("basil"))
+	if want := (Pizza{"large", []string{"olives", "basil"}}); !reflect.DeepEqual(pizza, want) {
+		return fmt.Errorf("NewPizza(olives, large, basil) = %+v, want %+v", pizza, want)
+	}
+	return nil
+}
+
+// builders-1
+// the conditions are joined with AND
+// Build writes SELECT * FROM table WHERE a AND b LIMIT n
+// leaving out the parts that were not set
+type QueryBuilder struct {
+	table      string
+	conditions []string
+	limit      int
+}
+
+func NewQueryBuilder(table string) *QueryBuilder {
+	return &QueryBuilder{table: table}
+}
+
+func (b *QueryBuilder) Where(condition string) *QueryBuilder {
+	// your code here
+	return b
+}
+
+func (b *QueryBuilder) Limit(limit int) *QueryBuilder {
+	// your code here
+	return b
+}
+
+func (b *QueryBuilder) Build() string {
+	// your code here
+	return ""
+}
+
+func checkQueryBuilder(build func(string, []string, int) string) error {
+	var tests = []struct {
+		table      string
+		conditions []string
+		limit      int
+		want       string
+	}{
+		{"cookies", nil, 0, "SELECT * FROM cookies"},
+		{"cookies", []string{"size > 3"}, 0, "SELECT * FROM cookies WHERE size > 3"},
+		{"cookies", []string{"size > 3", "rating = 5"}, 10, "SELECT * FROM cookies WHERE size > 3 AND rating = 5 LIMIT 10"},
+		{"cakes", nil, 1, "SELECT * FROM cakes LIMIT 1"},
+	}
+	for _, test := range tests {
+		if got := build(test.table, test.conditions, test.limit); got != test.want {
+			return fmt.Errorf("building from %v with [%v] limit %v = %q, want %q", test.table, strings.Join(test.conditions, ", "), test.limit, got, test.want)
+		}
+	}
+	return nil
+}
+
+// equality-1
+// a comparable struct makes a map key
+func CountDistinct(points []Point) int {
+	// your code here
+	return len(points)
+}
+
+func checkCountDistinct(countDistinct func([]Point) int) error {
+	var tests = []struct {
+		points []Point
+		want   int
+	}{
+		{nil, 0},
+		{[]Point{{1, 2}, {2, 1}}, 2},
+		{[]Point{{1, 2}, {3, 4}, {1, 2}, {1, 2}}, 2},
+	}
+	for _, test := range tests {
+		if got := countDistinct(test.points); got != test.want {
+			return fmt.Errorf("CountDistinct(%v) = %v, want %v", test.points, got, test.want)
+		}
+	}
+	return nil
+}
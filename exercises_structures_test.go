@@ -0,0 +1,189 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type countingWriterSolution struct {
+	io.Writer
+	count int
+}
+
+func (w *countingWriterSolution) Write(p []byte) (int, error) {
+	n, err := w.Writer.Write(p)
+	w.count += n
+	return n, err
+}
+
+// counts what the last write wrote
+// instead of adding it up
+type lastWriteCountingWriter struct {
+	io.Writer
+	count int
+}
+
+func (w *lastWriteCountingWriter) Write(p []byte) (int, error) {
+	w.count = len(p)
+	return w.Writer.Write(p)
+}
+
+func TestStructuresExerciseCheckers(t *testing.T) {
+	testCheckers(t, []checkerTest{
+		{
+			"structures-1",
+			func() error {
+				return checkMove(func(point *Point, dx, dy int) {
+					point.X += dx
+					point.Y += dy
+				})
+			},
+			func() error {
+				return checkMove(func(point *Point, dx, dy int) {
+					moved := *point
+					moved.X += dx
+					moved.Y += dy
+				})
+			},
+		},
+		{
+			"methods-1",
+			func() error {
+				return checkWithdraw(func(wallet *Wallet, amount int) bool {
+					if amount > wallet.Balance {
+						return false
+					}
+					wallet.Balance -= amount
+					return true
+				})
+			},
+			func() error {
+				return checkWithdraw(func(wallet *Wallet, amount int) bool {
+					wallet.Balance -= amount
+					return wallet.Balance >= 0
+				})
+			},
+		},
+		{
+			"embedding-1",
+			func() error {
+				return checkCountingWriter(func(w io.Writer) (io.Writer, func() int) {
+					counting := &countingWriterSolution{Writer: w}
+					return counting, func() int { return counting.count }
+				})
+			},
+			func() error {
+				return checkCountingWriter(func(w io.Writer) (io.Writer, func() int) {
+					counting := &lastWriteCountingWriter{Writer: w}
+					return counting, func() int { return counting.count }
+				})
+			},
+		},
+		{
+			"tags-1",
+			func() error {
+				return checkColumnNames(func(value any) []string {
+					var names []string
+					structType := reflect.TypeOf(value)
+					for i := 0; i < structType.NumField(); i++ {
+						field := structType.Field(i)
+						name, ok := field.Tag.Lookup("db")
+						switch {
+						case name == "-":
+							continue
+						case !ok:
+							name = field.Name
+						}
+						names = append(names, name)
+					}
+					return names
+				})
+			},
+			func() error {
+				return checkColumnNames(func(value any) []string {
+					var names []string
+					structType := reflect.TypeOf(value)
+					for i := 0; i < structType.NumField(); i++ {
+						if name := structType.Field(i).Tag.Get("db"); name != "-" {
+							names = append(names, name)
+						}
+					}
+					return names
+				})
+			},
+		},
+		{
+			"options-1",
+			func() error {
+				return checkNewPizza(
+					func(options ...PizzaOption) Pizza {
+						pizza := Pizza{Size: "medium"}
+						for _, option := range options {
+							option(&pizza)
+						}
+						return pizza
+					},
+					func(size string) PizzaOption { return func(p *Pizza) { p.Size = size } },
+					func(topping string) PizzaOption {
+						return func(p *Pizza) { p.Toppings = append(p.Toppings, topping) }
+					})
+			},
+			func() error {
+				return checkNewPizza(
+					func(options ...PizzaOption) Pizza {
+						pizza := Pizza{Size: "medium"}
+						for _, option := range options {
+							option(&pizza)
+						}
+						return pizza
+					},
+					func(size string) PizzaOption { return func(p *Pizza) { p.Size = size } },
+					func(topping string) PizzaOption { return func(p *Pizza) { p.Toppings = []string{topping} } })
+			},
+		},
+		{
+			"builders-1",
+			func() error {
+				return checkQueryBuilder(func(table string, conditions []string, limit int) string {
+					query := "SELECT * FROM " + table
+					if len(conditions) > 0 {
+						query += " WHERE " + strings.Join(conditions, " AND ")
+					}
+					if limit > 0 {
+						query += fmt.Sprintf(" LIMIT %v", limit)
+					}
+					return query
+				})
+			},
+			func() error {
+				return checkQueryBuilder(func(table string, conditions []string, limit int) string {
+					return fmt.Sprintf("SELECT * FROM %v WHERE %v LIMIT %v", table, strings.Join(conditions, " AND "), limit)
+				})
+			},
+		},
+		{
+			"equality-1",
+			func() error {
+				return checkCountDistinct(func(points []Point) int {
+					distinct := make(map[Point]bool)
+					for _, point := range points {
+						distinct[point] = true
+					}
+					return len(distinct)
+				})
+			},
+			func() error {
+				return checkCountDistinct(func(points []Point) int {
+					distinct := make(map[int]bool)
+					for _, point := range points {
+						distinct[point.X+point.Y] = true
+					}
+					return len(distinct)
+				})
+			},
+		},
+	})
+}
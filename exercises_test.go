@@ -0,0 +1,123 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// a checker must accept a correct implementation
+// and reject a wrong one whatever the stub contains
+type checkerTest struct {
+	name  string
+	right func() error
+	wrong func() error
+}
+
+// the wrong implementations that hang
+// make their checkers wait for hangLimit
+// so the checkers run in parallel
+func testCheckers(t *testing.T, tests []checkerTest) {
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			if err := test.right(); err != nil {
+				t.Errorf("rejected a correct implementation: %v", err)
+			}
+			if err := test.wrong(); err == nil {
+				t.Error("accepted a wrong implementation")
+			}
+		})
+	}
+}
+
+// the exemptions must stay in sync with the lessons
+func TestEveryLessonHasAnExercise(t *testing.T) {
+	practiced := make(map[string]bool)
+	for _, exercise := range registeredExercises {
+		if _, ok := Find(exercise.Lesson); !ok {
+			t.Errorf("exercise %v practices the unknown lesson %v", exercise.Name, exercise.Lesson)
+		}
+		practiced[exercise.Lesson] = true
+	}
+	for name := range lessonsWithoutExercises {
+		if _, ok := Find(name); !ok {
+			t.Errorf("the unknown lesson %v is exempted from exercises", name)
+		}
+		if practiced[name] {
+			t.Errorf("lesson %v is exempted but has an exercise", name)
+		}
+	}
+	for _, lesson := range List() {
+		if _, exempted := lessonsWithoutExercises[lesson.Name]; !practiced[lesson.Name] && !exempted {
+			t.Errorf("lesson %v has no exercise", lesson.Name)
+		}
+	}
+}
+
+// the stubs are left for the reader
+// so every exercise must fail as shipped
+// the checkers of hanging stubs wait for hangLimit
+// so they run in parallel
+func TestStubsFail(t *testing.T) {
+	for _, exercise := range registeredExercises {
+		t.Run(exercise.Name, func(t *testing.T) {
+			t.Parallel()
+			if err := exercise.Check(); err == nil {
+				t.Errorf("the stub of %v passes its checker", exercise.Name)
+			}
+		})
+	}
+}
+
+func TestListExercisesFollowsTheLessons(t *testing.T) {
+	position := make(map[string]int)
+	for i, lesson := range List() {
+		position[lesson.Name] = i
+	}
+	exercises := ListExercises()
+	if len(exercises) != len(registeredExercises) {
+		t.Fatalf("ListExercises() returned %v exercises, want %v", len(exercises), len(registeredExercises))
+	}
+	for i := 1; i < len(exercises); i++ {
+		if position[exercises[i-1].Lesson] > position[exercises[i].Lesson] {
+			t.Errorf("%v is listed before %v", exercises[i-1].Name, exercises[i].Name)
+		}
+	}
+}
+
+func TestRunExerciseRecoversPanics(t *testing.T) {
+	registeredExercisesReal := registeredExercises
+	defer func() { registeredExercises = registeredExercisesReal }()
+	registeredExercises = nil
+
+	RegisterExercise(Exercise{"panics-1", "panics", "panic", func() error {
+		var slice []int
+		return checkRemoveAt(func([]int, int) []int { return slice[:1] })
+	}})
+	var err error
+	captureOutput(func() {
+		err = RunExercise("panics-1")
+	})
+	if err == nil || !strings.Contains(err.Error(), "panicked") {
+		t.Errorf("RunExercise(panics-1) = %v, want a recovered panic", err)
+	}
+	if err := RunExercise("unknown"); err == nil {
+		t.Error("RunExercise(unknown) did not fail")
+	}
+}
+
+func TestFinishesWithin(t *testing.T) {
+	if !finishesWithin(hangLimit, func() {}) {
+		t.Error("finishesWithin gave up on a function that returned")
+	}
+	if finishesWithin(10*time.Millisecond, func() { select {} }) {
+		t.Error("finishesWithin waited for a function that hangs")
+	}
+	recovered := recovered(func() {
+		finishesWithin(hangLimit, func() { panic("boom") })
+	})
+	if recovered != "boom" {
+		t.Errorf("finishesWithin raised %v again, want boom", recovered)
+	}
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"fmt"
+	"time"
+)
+
+func init() {
+	RegisterExercise(Exercise{"dates-1", "dates", "parse a date written as 2006-01-02 or 02/01/2006",
+		func() error { return checkParseDate(ParseDate) }})
+	RegisterExercise(Exercise{"timers-1", "timers", "give up on a task that takes too long",
+		func() error { return checkRunWithTimeout(RunWithTimeout) }})
+	RegisterExercise(Exercise{"clocks-1", "clocks", "tell whether two times are the same instant",
+		func() error { return checkSameInstant(SameInstant) }})
+}
+
+// dates-1
+// the layout is written with the reference time
+// Mon Jan 2 15:04:05 MST 2006
+func ParseDate(value string) (time.Time, error) {
+	// your code here
+	return time.Time{}, nil
+}
+
+func checkParseDate(parseDate func(string) (time.Time, error)) error {
+	want := time.Date(2024, time.March, 7, 0, 0, 0, 0, time.UTC)
+	for _, value := range []string{"2024-03-07", "07/03/2024"} {
+		if got, err := parseDate(value); !got.Equal(want) || err != nil {
+			return fmt.Errorf("ParseDate(%v) = %v, %v, want %v, nil", value, got, err, want)
+		}
+	}
+	for _, value := range []string{"2024-13-07", "March 7", ""} {
+		if _, err := parseDate(value); err == nil {
+			return fmt.Errorf("ParseDate(%q) returned no error", value)
+		}
+	}
+	return nil
+}
+
+// timers-1
+// false when the task takes longer than limit
+// the task cannot be stopped
+// so it must not block forever on its result
+func RunWithTimeout(task func() int, limit time.Duration) (int, bool) {
+	// your code here
+	return task(), true
+}
+
+func checkRunWithTimeout(runWithTimeout func(func() int, time.Duration) (int, bool)) error {
+	quick := func() int { return 42 }
+	if got, ok := runWithTimeout(quick, hangLimit); got != 42 || !ok {
+		return fmt.Errorf("RunWithTimeout(quick) = %v, %v, want 42, true", got, ok)
+	}
+	release := make(chan struct{})
+	defer close(release)
+	stuck := func() int {
+		<-release
+		return 0
+	}
+	var ok bool
+	if !finishesWithin(hangLimit, func() { _, ok = runWithTimeout(stuck, 10*time.Millisecond) }) {
+		return fmt.Errorf("RunWithTimeout waited for a stuck task")
+	}
+	if ok {
+		return fmt.Errorf("RunWithTimeout(stuck) returned true")
+	}
+	return nil
+}
+
+// clocks-1
+// the same instant can be written
+// in different locations
+// and with or without a monotonic reading
+func SameInstant(a, b time.Time) bool {
+	// your code here
+	return a == b
+}
+
+func checkSameInstant(sameInstant func(time.Time, time.Time) bool) error {
+	now := time.Now()
+	paris := time.FixedZone("CET", 3600)
+	var tests = []struct {
+		a, b time.Time
+		want bool
+	}{
+		{now, now, true},
+		{now, now.Round(0), true},
+		{now, now.In(paris), true},
+		{now.UTC(), now.Add(time.Second).UTC(), false},
+	}
+	for _, test := range tests {
+		if got := sameInstant(test.a, test.b); got != test.want {
+			return fmt.Errorf("SameInstant(%v, %v) = %v, want %v", test.a, test.b, got, test.want)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTimeExerciseCheckers(t *testing.T) {
+	testCheckers(t, []checkerTest{
+		{
+			"dates-1",
+			func() error {
+				return checkParseDate(func(value string) (time.Time, error) {
+					date, err := time.Parse(time.DateOnly, value)
+					if err != nil {
+						return time.Parse("02/01/2006", value)
+					}
+					return date, nil
+				})
+			},
+			func() error {
+				return checkParseDate(func(value string) (time.Time, error) {
+					date, err := time.Parse(time.DateOnly, value)
+					if err != nil {
+						return time.Parse("01/02/2006", value)
+					}
+					return date, nil
+				})
+			},
+		},
+		{
+			"timers-1",
+			func() error {
+				return checkRunWithTimeout(func(task func() int, limit time.Duration) (int, bool) {
+					result := make(chan int, 1)
+					go func() { result <- task() }()
+					timer := time.NewTimer(limit)
+					defer timer.Stop()
+					select {
+					case value := <-result:
+						return value, true
+					case <-timer.C:
+						return 0, false
+					}
+				})
+			},
+			func() error {
+				return checkRunWithTimeout(func(task func() int, limit time.Duration) (int, bool) {
+					start := time.Now()
+					value := task()
+					return value, time.Since(start) <= limit
+				})
+			},
+		},
+		{
+			"clocks-1",
+			func() error { return checkSameInstant(time.Time.Equal) },
+			func() error {
+				return checkSameInstant(func(a, b time.Time) bool { return a.Round(0) == b.Round(0) })
+			},
+		},
+	})
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+)
+
+// fan in merges many channels into one
+// one goroutine per input forwards its values
+// the output closes once every input is closed
+func Merge[T any](chs ...<-chan T) <-chan T {
+	merged := make(chan T)
+	var wg sync.WaitGroup
+	for _, ch := range chs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for value := range ch {
+				merged <- value
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(merged)
+	}()
+	return merged
+}
+
+func countTo(n int) <-chan int {
+	out := make(chan int)
+	go func() {
+		defer close(out)
+		for i := 1; i <= n; i++ {
+			out <- i
+		}
+	}()
+	return out
+}
+
+// fan out starts several readers
+// on the same input channel
+// each value goes to whichever is free
+func cube(in <-chan int) <-chan int {
+	out := make(chan int)
+	go func() {
+		defer close(out)
+		for value := range in {
+			out <- value * value * value
+		}
+	}()
+	return out
+}
+
+func fanOutLesson() {
+
+	// fanning the numbers out to three cubers
+	// and their results back in
+	trace()
+	numbers := countTo(6)
+	cubes := Merge(cube(numbers), cube(numbers), cube(numbers))
+
+	var received []int
+	for value := range cubes {
+		received = append(received, value)
+	}
+	slices.Sort(received)
+	fmt.Printf("cubes: %v\n", received)
+	// Output:
+	// cubes: [1 8 27 64 125 216]
+
+	// merging works for any element type
+	// the values interleave in any order
+	trace()
+	greetings := make(chan string, 2)
+	farewells := make(chan string, 1)
+	greetings <- "hello"
+	greetings <- "hi"
+	farewells <- "bye"
+	close(greetings)
+	close(farewells)
+
+	var words []string
+	for word := range Merge(greetings, farewells) {
+		words = append(words, word)
+	}
+	slices.Sort(words)
+	fmt.Printf("words: %v\n", words)
+	// Output:
+	// words: [bye hello hi]
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestMergeReceivesEveryValue(t *testing.T) {
+	tests := []struct {
+		name   string
+		inputs [][]int
+		want   []int
+	}{
+		{"none", nil, nil},
+		{"one", [][]int{{1, 2}}, []int{1, 2}},
+		{"several", [][]int{{1, 4}, {}, {2, 3, 5}}, []int{1, 2, 3, 4, 5}},
+	}
+	for _, test := range tests {
+		var chs []<-chan int
+		for _, input := range test.inputs {
+			ch := make(chan int, len(input))
+			for _, value := range input {
+				ch <- value
+			}
+			close(ch)
+			chs = append(chs, ch)
+		}
+
+		var got []int
+		for value := range Merge(chs...) {
+			got = append(got, value)
+		}
+		slices.Sort(got)
+		if !slices.Equal(got, test.want) {
+			t.Errorf("%v: Merge received %v, want %v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestFanOutCubes(t *testing.T) {
+	numbers := countTo(4)
+	var got []int
+	for value := range Merge(cube(numbers), cube(numbers)) {
+		got = append(got, value)
+	}
+	slices.Sort(got)
+	if want := []int{1, 8, 27, 64}; !slices.Equal(got, want) {
+		t.Errorf("fanned out cubes = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,26 @@
+// Code generated by "stringer -type=Flavor"; DO NOT EDIT.
+
+package main
+
+import "strconv"
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[Vanilla-0]
+	_ = x[Chocolate-1]
+	_ = x[Pistachios-2]
+}
+
+const _Flavor_name = "VanillaChocolatePistachios"
+
+var _Flavor_index = [...]uint8{0, 7, 16, 26}
+
+func (i Flavor) String() string {
+	idx := int(i) - 0
+	if i < 0 || idx >= len(_Flavor_index)-1 {
+		return "Flavor(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _Flavor_name[_Flavor_index[idx]:_Flavor_index[idx+1]]
+}
@@ -0,0 +1,510 @@
+package main
+
+import (
+	"bufio"
+	"cmp"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"strconv"
+)
+
+func init() {
+	RegisterChapter(Chapter{4, "Functions", []Lesson{
+		{"functions", "functions, closures and generics", functionsLesson, []string{"func", "closure", "variadic", "generics", "cmp.Ordered"}, []string{"variables"}, []string{"beginner", "functions", "generics"}},
+		{"panics", "defer, panic and recover", panicsLesson, []string{"defer", "panic", "recover", "goroutine", "crash", "LIFO", "named result"}, []string{"functions"}, []string{"intermediate", "functions", "errors"}},
+		{"errors", "joining errors", errorsLesson, []string{"error", "errors.Join", "errors.Is", "strconv", "cleanup", "io.Closer"}, []string{"functions"}, []string{"intermediate", "errors"}},
+		{"wrapping", "wrapping errors with %w, errors.Is and errors.As", wrappingLesson, []string{"%w", "errors.Is", "errors.As", "errors.Unwrap", "fs.ErrNotExist", "wrap"}, []string{"errors"}, []string{"intermediate", "errors"}},
+		{"custom-errors", "custom error types and errors.As", customErrorsLesson, []string{"error", "Error", "custom error", "errors.As", "ValidationError"}, []string{"wrapping", "structures"}, []string{"intermediate", "errors"}},
+		{"sentinels", "sentinel errors and errors.Is", sentinelsLesson, []string{"error", "sentinel", "ErrNotFound", "errors.New", "errors.Is", "map"}, []string{"wrapping", "maps"}, []string{"intermediate", "errors"}},
+		{"files", "reading files line by line", filesLesson, []string{"os.Open", "bufio.Scanner", "defer", "file"}, []string{"errors", "panics"}, []string{"intermediate", "errors", "io"}},
+	}})
+}
+
+// function signatures
+func noReturn() {
+}
+func oneReturn() bool {
+	return false
+}
+func multipleReturns() (bool, int) {
+	return true, 25
+}
+func bareReturns() (x, y int) {
+	x = 1
+	y = 2
+	return
+}
+
+// there is no tail call optimization
+// but we get auto-growing stacks
+func recurse(x int) {
+	if x < 1000 {
+		recurse(x + 1)
+	}
+}
+
+// error handling
+func ooops() error {
+	return fmt.Errorf("damn thing exploded")
+}
+func errorPropagation() error {
+	err := ooops()
+	if err != nil {
+		return err
+	}
+	return nil
+}
+func errorWithContext(color string) error {
+	err := ooops()
+	if err != nil {
+		return fmt.Errorf("while trying to paint %s: %v", color, err)
+	}
+	return nil
+}
+
+// reading a file line by line
+func countLines(path string) (int, error) {
+
+	// opening can fail
+	// wrap the error with some context
+	file, err := os.Open(path)
+	if err != nil {
+		return 0, fmt.Errorf("while counting lines of %s: %w", path, err)
+	}
+
+	// closing is deferred right after
+	// a successful open so every return path runs it
+	defer file.Close()
+
+	// a scanner splits the input on newlines
+	count := 0
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		count++
+	}
+
+	// scanning stops silently on errors
+	// so they must be checked after the loop
+	if err := scanner.Err(); err != nil {
+		return 0, fmt.Errorf("while counting lines of %s: %w", path, err)
+	}
+	return count, nil
+}
+
+// processing a batch keeps going when an item fails
+// every failure is collected and joined into one error
+// which is nil when nothing failed
+func parseQuantities(inputs []string) ([]int, error) {
+	var quantities []int
+	var errs []error
+	for _, input := range inputs {
+		quantity, err := strconv.Atoi(input)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("while parsing %q: %w", input, err))
+			continue
+		}
+		quantities = append(quantities, quantity)
+	}
+	return quantities, errors.Join(errs...)
+}
+
+// a resource that may fail to close
+type closable struct {
+	name string
+	err  error
+}
+
+func (c *closable) Close() error {
+	fmt.Printf("closing %v\n", c.name)
+	if c.err != nil {
+		return fmt.Errorf("while closing %v: %w", c.name, c.err)
+	}
+	return nil
+}
+
+// closing every resource even when some fail
+// in the reverse order they were opened
+// errors.Join skips the nil errors
+func closeAll(closers ...io.Closer) error {
+	var errs []error
+	for i := len(closers) - 1; i >= 0; i-- {
+		errs = append(errs, closers[i].Close())
+	}
+	return errors.Join(errs...)
+}
+
+// the deferred cleanup joins its failures
+// to the error the work returned
+func exportReport(work error, closers ...io.Closer) (err error) {
+	defer func() {
+		err = errors.Join(err, closeAll(closers...))
+	}()
+	return work
+}
+
+// defers run when the function returns
+// not at the end of each iteration
+// every resource stays open until the loop is done
+func openAllDeferred(names []string) {
+	for _, name := range names {
+		resource := &closable{name, nil}
+		fmt.Printf("opened %v\n", name)
+		defer resource.Close()
+	}
+	fmt.Println("done with all")
+}
+
+// a function per iteration
+// closes each resource before the next is opened
+func openAll(names []string) {
+	for _, name := range names {
+		func() {
+			resource := &closable{name, nil}
+			fmt.Printf("opened %v\n", name)
+			defer resource.Close()
+		}()
+	}
+	fmt.Println("done with all")
+}
+
+// a deferred closure runs after return
+// has set the named results
+// and can still change them
+func safeDivide(a, b int) (quotient int, err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = fmt.Errorf("while dividing %v by %v: %v", a, b, recovered)
+		}
+	}()
+	return a / b, nil
+}
+
+// a panic in a goroutine is never contained
+// a recover deferred by the goroutine that started it
+// does not help, the whole program crashes
+// the recover must be deferred inside the goroutine itself
+// turning the panic into an error
+// sent on a channel like any other failure
+// so the caller handles both the same way
+func goRecovering(task func() error) <-chan error {
+	errs := make(chan error, 1)
+	go func() {
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				errs <- fmt.Errorf("goroutine panicked: %v", recovered)
+			}
+		}()
+		errs <- task()
+	}()
+	return errs
+}
+
+// cmp.Ordered is the set of types
+// supporting < <= >= and >
+// integers, floats and strings
+// no need for a hand rolled Number constraint
+// when only comparisons are needed
+func Min[T cmp.Ordered](a, b T) T {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func Max[T cmp.Ordered](a, b T) T {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// reversed bounds are swapped
+func Clamp[T cmp.Ordered](v, lo, hi T) T {
+	if lo > hi {
+		lo, hi = hi, lo
+	}
+	return Min(Max(v, lo), hi)
+}
+
+// functions as values
+func addNumbers(x, y int) int {
+	return x + y
+}
+
+func functionsLesson() {
+
+	// returns
+	trace()
+	noReturn()
+	_ = oneReturn()
+	_, _ = multipleReturns()
+	_, _ = bareReturns()
+
+	// functions as values
+	trace()
+	var functionAsValue func(int, int) int = addNumbers
+	fmt.Println(functionAsValue(1, 2))
+	// Output:
+	// 3
+
+	// anonymous functions
+	trace()
+	plusOne := func(x int) int { return x + 1 }
+	fmt.Println(plusOne(1))
+	// Output:
+	// 2
+
+	// closures
+	trace()
+	someNumber := 25
+	plusTwo := func() int { return someNumber + 2 }
+	fmt.Println(plusTwo())
+	// Output:
+	// 27
+
+	// but by reference
+	trace()
+	someNumber = 50
+	fmt.Println(plusTwo())
+	// Output:
+	// 52
+
+	// leading to weird patterns
+	// where closed values need to be copied
+	trace()
+	plusThreeNumber := someNumber
+	plusThree := func() int { return plusThreeNumber + 3 }
+	someNumber = 75
+	fmt.Println(plusThree())
+	// Output:
+	// 53
+
+	// variadic functions
+	trace()
+	bigCompute := func(values ...int) int {
+		return len(values)
+	}
+	bigComputeValues := []int{1, 2, 3}
+	fmt.Println(bigCompute(1, 2, 3))
+	fmt.Println(bigCompute(bigComputeValues...))
+	// Output:
+	// 3
+	// 3
+
+	// generic functions over ordered types
+	trace()
+	fmt.Println(Min(3, 7), Max(2.5, 1.5), Clamp(15, 0, 10))
+	fmt.Println(Min("banana", "apple"), Clamp("kiwi", "lemon", "orange"))
+	// Output:
+	// 3 2.5 10
+	// apple lemon
+}
+
+func panicsLesson() {
+
+	// deferred function calls
+	trace()
+	doStuff := func() {
+		fmt.Println("enter")
+		defer fmt.Println("executed when the function exits")
+		{
+			defer fmt.Println("not when a block exits")
+		}
+		fmt.Println("exit")
+	}
+	doStuff()
+	// Output:
+	// enter
+	// exit
+	// not when a block exits
+	// executed when the function exits
+
+	// the arguments of a deferred call
+	// are evaluated when defer runs
+	// a closure reads the variable when it is called
+	trace()
+	func() {
+		count := 1
+		defer fmt.Printf("argument count: %v\n", count)
+		defer func() {
+			fmt.Printf("closure count: %v\n", count)
+		}()
+		count = 2
+	}()
+	// Output:
+	// closure count: 2
+	// argument count: 1
+
+	// several defers run in reverse order
+	// last in first out like a stack
+	trace()
+	func() {
+		for i := range 3 {
+			defer fmt.Printf("deferred %v\n", i)
+		}
+	}()
+	// Output:
+	// deferred 2
+	// deferred 1
+	// deferred 0
+
+	// defers in a loop pile up
+	// until the function returns
+	trace()
+	openAllDeferred([]string{"first", "second"})
+	// Output:
+	// opened first
+	// opened second
+	// done with all
+	// closing second
+	// closing first
+
+	// a function per iteration releases them in time
+	trace()
+	openAll([]string{"first", "second"})
+	// Output:
+	// opened first
+	// closing first
+	// opened second
+	// closing second
+	// done with all
+
+	// changing a named result
+	// turning a panic into an error
+	trace()
+	quotient, err := safeDivide(7, 2)
+	fmt.Printf("quotient: %v, err: %v\n", quotient, err)
+	quotient, err = safeDivide(7, 0)
+	fmt.Printf("quotient: %v, err: %v\n", quotient, err)
+	// Output:
+	// quotient: 3, err: <nil>
+	// quotient: 0, err: while dividing 7 by 0: runtime error: integer divide by zero
+
+	// panicking
+	trace()
+	ohNoes := func() {
+		panic("we are screwed")
+	}
+
+	// recovering
+	trace()
+	keepCalm := func() {
+		defer func() {
+			whatNow := recover()
+			fmt.Println(whatNow)
+		}()
+		ohNoes()
+		fmt.Println("too bad won't execute")
+	}
+	keepCalm()
+	// Output:
+	// we are screwed
+
+	// recovering from the spawning goroutine
+	// never sees the panic of another goroutine
+	// calling this would crash the whole program
+	// panic: we are screwed
+	// goroutine 7 [running]:
+	trace()
+	crashTheProgram := func() {
+		defer func() {
+			fmt.Println("never recovers", recover())
+		}()
+		done := make(chan bool)
+		go func() {
+			ohNoes()
+			done <- true
+		}()
+		<-done
+	}
+	_ = crashTheProgram
+
+	// recovering inside each goroutine
+	// their panics become errors
+	// and the main flow continues
+	trace()
+	tasks := []func() error{
+		func() error { return nil },
+		func() error { return errors.New("disk is full") },
+		func() error { ohNoes(); return nil },
+	}
+	var results []<-chan error
+	for _, task := range tasks {
+		results = append(results, goRecovering(task))
+	}
+	for i, result := range results {
+		fmt.Printf("task %v: %v\n", i, <-result)
+	}
+	// Output:
+	// task 0: <nil>
+	// task 1: disk is full
+	// task 2: goroutine panicked: we are screwed
+}
+
+func errorsLesson() {
+
+	// joined errors report every failure
+	// and match any of their members
+	trace()
+	quantities, err := parseQuantities([]string{"1", "two", "3", "99999999999999999999"})
+	fmt.Printf("parsed quantities %v\n", quantities)
+	fmt.Println(err)
+	fmt.Printf("has a syntax error: %v\n", errors.Is(err, strconv.ErrSyntax))
+	fmt.Printf("has a range error: %v\n", errors.Is(err, strconv.ErrRange))
+	// Output:
+	// parsed quantities [1 3]
+	// while parsing "two": strconv.Atoi: parsing "two": invalid syntax
+	// while parsing "99999999999999999999": strconv.Atoi: parsing "99999999999999999999": value out of range
+	// has a syntax error: true
+	// has a range error: true
+
+	// cleaning up after several resources
+	// every close is attempted even after one fails
+	// and their failures do not hide
+	// the failure of the work itself
+	trace()
+	database := &closable{"database", nil}
+	cache := &closable{"cache", fs.ErrClosed}
+	logFile := &closable{"log file", io.ErrShortWrite}
+	err = exportReport(errors.New("report is empty"), database, cache, logFile)
+	fmt.Println(err)
+	// Output:
+	// closing log file
+	// closing cache
+	// closing database
+	// report is empty
+	// while closing log file: short write
+	// while closing cache: file already closed
+}
+
+func filesLesson() {
+
+	// reading files
+	// CreateTemp picks a unique name
+	// so parallel runs do not collide
+	trace()
+	linesFile, err := os.CreateTemp("", "lines-*.txt")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	defer os.Remove(linesFile.Name())
+	_, err = linesFile.WriteString("one\ntwo\nthree\n")
+	if closeErr := linesFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	linesCount, err := countLines(linesFile.Name())
+	fmt.Printf("counted %v lines, err: %v\n", linesCount, err)
+
+	// a missing file returns the wrapped error
+	trace()
+	_, err = countLines(linesFile.Name() + ".missing")
+	fmt.Println(err)
+}
@@ -0,0 +1,163 @@
+package main
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestParseQuantitiesJoinsErrors(t *testing.T) {
+	quantities, err := parseQuantities([]string{"1", "two", "3", "99999999999999999999", "4"})
+	if got, want := quantities, []int{1, 3, 4}; !reflect.DeepEqual(got, want) {
+		t.Errorf("quantities = %v, want %v", got, want)
+	}
+	if !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("%v does not match strconv.ErrSyntax", err)
+	}
+	if !errors.Is(err, strconv.ErrRange) {
+		t.Errorf("%v does not match strconv.ErrRange", err)
+	}
+
+	if _, err := parseQuantities([]string{"1", "2"}); err != nil {
+		t.Errorf("parseQuantities failed without bad inputs: %v", err)
+	}
+}
+
+func TestCloseAllJoinsErrors(t *testing.T) {
+	first := &closable{"first", fs.ErrClosed}
+	second := &closable{"second", nil}
+	third := &closable{"third", io.ErrShortWrite}
+	var err error
+	output, captureErr := captureOutput(func() {
+		err = closeAll(first, second, third)
+	})
+	if captureErr != nil {
+		t.Fatal(captureErr)
+	}
+	if want := "closing third\nclosing second\nclosing first\n"; output != want {
+		t.Errorf("closeAll printed %q, want %q", output, want)
+	}
+	if !errors.Is(err, fs.ErrClosed) {
+		t.Errorf("%v does not match fs.ErrClosed", err)
+	}
+	if !errors.Is(err, io.ErrShortWrite) {
+		t.Errorf("%v does not match io.ErrShortWrite", err)
+	}
+
+	captureOutput(func() {
+		err = closeAll(second)
+	})
+	if err != nil {
+		t.Errorf("closeAll failed without bad resources: %v", err)
+	}
+}
+
+func TestExportReportKeepsWorkError(t *testing.T) {
+	work := errors.New("work failed")
+	var err error
+	captureOutput(func() {
+		err = exportReport(work, &closable{"resource", fs.ErrClosed})
+	})
+	if !errors.Is(err, work) {
+		t.Errorf("%v does not match the work error", err)
+	}
+	if !errors.Is(err, fs.ErrClosed) {
+		t.Errorf("%v does not match fs.ErrClosed", err)
+	}
+}
+
+func TestGoRecoveringCatchesPanics(t *testing.T) {
+	if err := <-goRecovering(func() error { panic("boom") }); err == nil || !strings.Contains(err.Error(), "boom") {
+		t.Errorf("goRecovering(panic) = %v, want an error about boom", err)
+	}
+	failure := errors.New("failure")
+	if err := <-goRecovering(func() error { return failure }); err != failure {
+		t.Errorf("goRecovering(failure) = %v, want %v", err, failure)
+	}
+	if err := <-goRecovering(func() error { return nil }); err != nil {
+		t.Errorf("goRecovering(nil) = %v, want nil", err)
+	}
+}
+
+func TestSafeDivide(t *testing.T) {
+	if quotient, err := safeDivide(7, 2); quotient != 3 || err != nil {
+		t.Errorf("safeDivide(7, 2) = %v, %v, want 3, nil", quotient, err)
+	}
+	if quotient, err := safeDivide(7, 0); quotient != 0 || err == nil {
+		t.Errorf("safeDivide(7, 0) = %v, %v, want 0 and an error", quotient, err)
+	}
+}
+
+func TestMinMaxClamp(t *testing.T) {
+	var tests = []struct {
+		v, lo, hi         int
+		min, max, clamped int
+	}{
+		{5, 0, 10, 0, 10, 5},
+		{-5, 0, 10, 0, 10, 0},
+		{15, 0, 10, 0, 10, 10},
+		{7, 7, 7, 7, 7, 7},
+		{15, 10, 0, 0, 10, 10},
+		{-5, 10, 0, 0, 10, 0},
+	}
+	for _, test := range tests {
+		if got := Min(test.lo, test.hi); got != test.min {
+			t.Errorf("Min(%v, %v) = %v, want %v", test.lo, test.hi, got, test.min)
+		}
+		if got := Max(test.lo, test.hi); got != test.max {
+			t.Errorf("Max(%v, %v) = %v, want %v", test.lo, test.hi, got, test.max)
+		}
+		if got := Clamp(test.v, test.lo, test.hi); got != test.clamped {
+			t.Errorf("Clamp(%v, %v, %v) = %v, want %v", test.v, test.lo, test.hi, got, test.clamped)
+		}
+	}
+}
+
+func TestMinMaxClampStrings(t *testing.T) {
+	var tests = []struct {
+		v, lo, hi string
+		want      string
+	}{
+		{"kiwi", "lemon", "orange", "lemon"},
+		{"mango", "lemon", "orange", "mango"},
+		{"peach", "orange", "lemon", "orange"},
+	}
+	for _, test := range tests {
+		if got := Clamp(test.v, test.lo, test.hi); got != test.want {
+			t.Errorf("Clamp(%q, %q, %q) = %q, want %q", test.v, test.lo, test.hi, got, test.want)
+		}
+	}
+	if got := Min("banana", "apple"); got != "apple" {
+		t.Errorf("Min(banana, apple) = %q, want apple", got)
+	}
+	if got := Max(1.5, 2.5); got != 2.5 {
+		t.Errorf("Max(1.5, 2.5) = %v, want 2.5", got)
+	}
+}
+
+func TestCountLines(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "lines.txt")
+	if err := os.WriteFile(path, []byte("one\ntwo\n\nfour"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	count, err := countLines(path)
+	if err != nil {
+		t.Fatalf("countLines failed: %v", err)
+	}
+	if count != 4 {
+		t.Errorf("countLines = %v, want 4", count)
+	}
+}
+
+func TestCountLinesMissingFile(t *testing.T) {
+	_, err := countLines(filepath.Join(t.TempDir(), "missing.txt"))
+	if !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("countLines error = %v, want fs.ErrNotExist", err)
+	}
+}
@@ -0,0 +1,264 @@
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+func init() {
+	RegisterChapter(Chapter{7, "Generics", []Lesson{
+		{"generics", "type parameters on functions and types", genericsLesson, []string{"generics", "type parameter", "instantiation", "type inference", "any"}, []string{"functions", "interfaces"}, []string{"intermediate", "generics"}},
+		{"constraints", "type sets, comparable and any", constraintsLesson, []string{"constraint", "type set", "~", "comparable", "any", "interface{}"}, []string{"generics"}, []string{"intermediate", "generics"}},
+		{"containers", "generic stacks, queues and sets", containersLesson, []string{"Stack", "Queue", "Set", "generic type", "container"}, []string{"constraints", "methods"}, []string{"intermediate", "generics", "collections"}},
+		{"functional", "Map, Filter and Reduce versus loops", functionalLesson, []string{"Map", "Filter", "Reduce", "higher order function", "loop"}, []string{"generics"}, []string{"intermediate", "generics", "functions"}},
+		{"iterators", "range over function iterators", iteratorsLesson, []string{"iter.Seq", "iter.Seq2", "iterator", "yield", "range over func", "slices.Collect"}, []string{"generics", "containers"}, []string{"advanced", "generics", "collections"}},
+	}})
+}
+
+// type parameters are declared in square brackets
+// before the regular parameters
+// any accepts every type
+func First[T any](values []T) (T, bool) {
+	var zero T
+	if len(values) == 0 {
+		return zero, false
+	}
+	return values[0], true
+}
+
+// types can have type parameters too
+type Pair[K any, V any] struct {
+	Key   K
+	Value V
+}
+
+// methods use the type parameters of their type
+// but cannot declare new ones
+func (p Pair[K, V]) String() string {
+	return fmt.Sprintf("%v=%v", p.Key, p.Value)
+}
+
+// so swapping the types of a pair
+// takes a function
+func Swap[K, V any](p Pair[K, V]) Pair[V, K] {
+	return Pair[V, K]{p.Value, p.Key}
+}
+
+func genericsLesson() {
+
+	// instantiating a generic function
+	// gives the type arguments in square brackets
+	trace()
+	firstInt := First[int]
+	number, _ := firstInt([]int{3, 4})
+	fmt.Printf("first int: %v\n", number)
+	// Output:
+	// first int: 3
+
+	// the type arguments are usually inferred
+	// from the regular arguments
+	trace()
+	name, _ := First([]string{"Alice", "Bob"})
+	fmt.Printf("first name: %v\n", name)
+	// Output:
+	// first name: Alice
+
+	// the zero value of a type parameter
+	// is declared with var
+	trace()
+	nothing, ok := First([]float64{})
+	fmt.Printf("nothing: %v, ok: %v\n", nothing, ok)
+	// Output:
+	// nothing: 0, ok: false
+
+	// instantiating a generic type
+	// its type arguments are never inferred
+	// from the values of its fields
+	trace()
+	pair := Pair[string, int]{"answer", 42}
+	fmt.Printf("pair: %v, swapped: %v\n", pair, Swap(pair))
+	// Output:
+	// pair: answer=42, swapped: 42=answer
+
+	// every instantiation is a distinct type
+	trace()
+	fmt.Printf("%T\n%T\n", pair, Swap(pair))
+	// Output:
+	// main.Pair[string,int]
+	// main.Pair[int,string]
+}
+
+// a constraint is an interface
+// listing the types it allows
+// ~ also allows the types
+// whose underlying type is listed
+// interfaces with type sets
+// can only be used as constraints
+type Number interface {
+	~int | ~int64 | ~float64
+}
+
+func Sum[T Number](values ...T) T {
+	var sum T
+	for _, value := range values {
+		sum += value
+	}
+	return sum
+}
+
+// comparable allows == and !=
+// which looking for a value needs
+func Contains[T comparable](values []T, wanted T) bool {
+	for _, value := range values {
+		if value == wanted {
+			return true
+		}
+	}
+	return false
+}
+
+func constraintsLesson() {
+
+	// the operators of a type set
+	// work on all of its types
+	trace()
+	fmt.Printf("ints: %v, floats: %v\n", Sum(1, 2, 3), Sum(1.5, 2.5))
+	// Output:
+	// ints: 6, floats: 4
+
+	// ~ lets named types in
+	// without it Sum would not accept Celsius
+	trace()
+	type Celsius float64
+	temperatures := []Celsius{20.5, 22}
+	fmt.Printf("total: %v\n", Sum(temperatures...))
+	// Output:
+	// total: 42.5
+
+	// comparable types
+	trace()
+	fmt.Printf("contains Bob: %v\n", Contains([]string{"Alice", "Bob"}, "Bob"))
+	fmt.Printf("contains 5: %v\n", Contains([]int{1, 2}, 5))
+	// Output:
+	// contains Bob: true
+	// contains 5: false
+
+	// structures are comparable
+	// when all their fields are
+	trace()
+	type Point struct{ X, Y int }
+	fmt.Printf("contains point: %v\n", Contains([]Point{{1, 2}}, Point{1, 2}))
+	// Output:
+	// contains point: true
+
+	// any is an alias of interface{}
+	// the same type spelled differently
+	trace()
+	var anything any = 42
+	var empty interface{} = anything
+	fmt.Printf("same type: %v\n", reflect.TypeOf(&anything).Elem() == reflect.TypeOf(&empty).Elem())
+	// Output:
+	// same type: true
+}
+
+// applying a function to every value
+// the result can be of another type
+func Map[T, U any](values []T, f func(T) U) []U {
+	mapped := make([]U, 0, len(values))
+	for _, value := range values {
+		mapped = append(mapped, f(value))
+	}
+	return mapped
+}
+
+// keeping the values a function accepts
+func Filter[T any](values []T, keep func(T) bool) []T {
+	var filtered []T
+	for _, value := range values {
+		if keep(value) {
+			filtered = append(filtered, value)
+		}
+	}
+	return filtered
+}
+
+// folding the values into one
+// starting from an initial value
+func Reduce[T, A any](values []T, initial A, f func(A, T) A) A {
+	accumulated := initial
+	for _, value := range values {
+		accumulated = f(accumulated, value)
+	}
+	return accumulated
+}
+
+// the same computation written both ways
+func sumOfSquaredEvensFunctional(numbers []int) int {
+	evens := Filter(numbers, func(n int) bool { return n%2 == 0 })
+	squares := Map(evens, func(n int) int { return n * n })
+	return Reduce(squares, 0, func(sum, n int) int { return sum + n })
+}
+
+func sumOfSquaredEvensLoop(numbers []int) int {
+	sum := 0
+	for _, n := range numbers {
+		if n%2 == 0 {
+			sum += n * n
+		}
+	}
+	return sum
+}
+
+func functionalLesson() {
+
+	// mapping to another type
+	trace()
+	names := Map([]int{1, 2, 3}, func(n int) string { return strings.Repeat("*", n) })
+	fmt.Printf("mapped: %q\n", names)
+	// Output:
+	// mapped: ["*" "**" "***"]
+
+	// filtering
+	trace()
+	long := Filter([]string{"go", "rust", "c", "zig"}, func(s string) bool { return len(s) > 1 })
+	fmt.Printf("filtered: %v\n", long)
+	// Output:
+	// filtered: [go rust zig]
+
+	// reducing into a value of another type
+	trace()
+	lengths := Reduce([]string{"go", "rust"}, map[string]int{}, func(m map[string]int, s string) map[string]int {
+		m[s] = len(s)
+		return m
+	})
+	fmt.Printf("reduced: %v\n", lengths)
+	// Output:
+	// reduced: map[go:2 rust:4]
+
+	// chaining them versus a plain loop
+	// both give the same result
+	trace()
+	numbers := []int{1, 2, 3, 4, 5, 6}
+	fmt.Printf("functional: %v, loop: %v\n", sumOfSquaredEvensFunctional(numbers), sumOfSquaredEvensLoop(numbers))
+	// Output:
+	// functional: 56, loop: 56
+
+	// idiomatic go still prefers the loop
+	// it allocates no intermediate slices
+	// can break early and reads top to bottom
+	// go test -bench SumOfSquaredEvens
+	// the helpers pay off when the function
+	// is reused or passed around
+	trace()
+	firstNegative := -1
+	for i, n := range []int{3, -1, 4, -5} {
+		if n < 0 {
+			firstNegative = i
+			break
+		}
+	}
+	fmt.Printf("first negative at: %v\n", firstNegative)
+	// Output:
+	// first negative at: 1
+}
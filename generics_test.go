@@ -0,0 +1,111 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFirst(t *testing.T) {
+	if got, ok := First([]string{"a", "b"}); got != "a" || !ok {
+		t.Errorf("First([a b]) = %v, %v, want a, true", got, ok)
+	}
+	if got, ok := First([]int(nil)); got != 0 || ok {
+		t.Errorf("First(nil) = %v, %v, want 0, false", got, ok)
+	}
+}
+
+func TestSwap(t *testing.T) {
+	swapped := Swap(Pair[string, int]{"answer", 42})
+	if swapped.Key != 42 || swapped.Value != "answer" {
+		t.Errorf("Swap(answer=42) = %v, want 42=answer", swapped)
+	}
+	if got := swapped.String(); got != "42=answer" {
+		t.Errorf("String() = %v, want 42=answer", got)
+	}
+}
+
+func TestSum(t *testing.T) {
+	if got := Sum(1, 2, 3); got != 6 {
+		t.Errorf("Sum(1, 2, 3) = %v, want 6", got)
+	}
+	if got := Sum(1.5, 2.5); got != 4 {
+		t.Errorf("Sum(1.5, 2.5) = %v, want 4", got)
+	}
+	if got := Sum[int](); got != 0 {
+		t.Errorf("Sum() = %v, want 0", got)
+	}
+}
+
+func TestContains(t *testing.T) {
+	var tests = []struct {
+		values []string
+		wanted string
+		want   bool
+	}{
+		{nil, "a", false},
+		{[]string{"a"}, "a", true},
+		{[]string{"a", "b"}, "b", true},
+		{[]string{"a", "b"}, "c", false},
+	}
+	for _, test := range tests {
+		if got := Contains(test.values, test.wanted); got != test.want {
+			t.Errorf("Contains(%v, %v) = %v, want %v", test.values, test.wanted, got, test.want)
+		}
+	}
+}
+
+func TestMapFilterReduce(t *testing.T) {
+	if got := Map([]int{1, 2}, func(n int) string { return string(rune('a' + n)) }); !reflect.DeepEqual(got, []string{"b", "c"}) {
+		t.Errorf("Map() = %v, want [b c]", got)
+	}
+	if got := Filter([]int{1, 2, 3, 4}, func(n int) bool { return n > 2 }); !reflect.DeepEqual(got, []int{3, 4}) {
+		t.Errorf("Filter() = %v, want [3 4]", got)
+	}
+	if got := Reduce([]int{1, 2, 3}, "", func(s string, n int) string { return s + string(rune('0'+n)) }); got != "123" {
+		t.Errorf("Reduce() = %v, want 123", got)
+	}
+}
+
+func TestSumOfSquaredEvens(t *testing.T) {
+	var tests = []struct {
+		numbers []int
+		want    int
+	}{
+		{nil, 0},
+		{[]int{1, 3}, 0},
+		{[]int{1, 2, 3, 4, 5, 6}, 56},
+	}
+	for _, test := range tests {
+		if got := sumOfSquaredEvensFunctional(test.numbers); got != test.want {
+			t.Errorf("sumOfSquaredEvensFunctional(%v) = %v, want %v", test.numbers, got, test.want)
+		}
+		if got := sumOfSquaredEvensLoop(test.numbers); got != test.want {
+			t.Errorf("sumOfSquaredEvensLoop(%v) = %v, want %v", test.numbers, got, test.want)
+		}
+	}
+}
+
+var squaredEvensNumbers = func() []int {
+	numbers := make([]int, 1000)
+	for i := range numbers {
+		numbers[i] = i
+	}
+	return numbers
+}()
+
+// the functional version allocates
+// two intermediate slices
+// go test -bench SumOfSquaredEvens
+func BenchmarkSumOfSquaredEvensFunctional(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		sumOfSquaredEvensFunctional(squaredEvensNumbers)
+	}
+}
+
+func BenchmarkSumOfSquaredEvensLoop(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		sumOfSquaredEvensLoop(squaredEvensNumbers)
+	}
+}
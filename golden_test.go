@@ -0,0 +1,57 @@
+package main
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// regenerating the golden files
+// go test -run TestGoldenOutputs -update
+var update = flag.Bool("update", false, "update the golden files")
+
+// lessons whose output cannot be compared
+var skippedGoldenLessons = map[string]string{
+	"maps":    "map iteration order is random",
+	"files":   "the temporary directory differs between machines",
+	"logging": "log records are timestamped",
+	"stacks":  "goroutine ids and counts change between runs",
+}
+
+// goroutines take turns
+// so they print in a stable order
+func TestGoldenOutputs(t *testing.T) {
+	deterministicReal := deterministic
+	defer func() { deterministic = deterministicReal }()
+	deterministic = true
+
+	for _, lesson := range List() {
+		t.Run(lesson.Name, func(t *testing.T) {
+			if reason, ok := skippedGoldenLessons[lesson.Name]; ok {
+				t.Skip(reason)
+			}
+
+			output, err := captureOutput(lesson.Run)
+			if err != nil {
+				t.Fatalf("capturing the output failed: %v", err)
+			}
+
+			path := filepath.Join("testdata", lesson.Name+".golden")
+			if *update {
+				if err := os.WriteFile(path, []byte(output), 0644); err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+
+			want, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatalf("reading the golden file failed: %v", err)
+			}
+			if output != string(want) {
+				t.Errorf("output differs from %v\ngot:\n%v\nwant:\n%v", path, output, string(want))
+			}
+		})
+	}
+}
@@ -0,0 +1,12 @@
+package initorder
+
+// greeting needs name from b.go
+// so name is initialized first
+// even though it is declared later
+var greeting = record("a.go: greeting, after the name it needs") + name
+
+// init functions run once every variable is ready
+// they cannot be called or referenced
+func init() {
+	record("a.go: init")
+}
@@ -0,0 +1,15 @@
+package initorder
+
+var name = record("b.go: name")
+
+var farewell = record("b.go: farewell")
+
+// a file may have several init functions
+// they run in the order they are declared
+func init() {
+	record("b.go: first init")
+}
+
+func init() {
+	record("b.go: second init")
+}
@@ -0,0 +1,24 @@
+// recording the order package variables
+// and init functions run in
+// a package initializes after the packages it imports
+// its variables first, then its init functions
+// in the order the files are given to the compiler
+// which go build sorts by name
+package initorder
+
+var events []string
+
+func record(event string) string {
+	events = append(events, event)
+	return event
+}
+
+// importing packages record their own events
+// they always come after the ones of this package
+func Record(event string) string {
+	return record(event)
+}
+
+func Events() []string {
+	return append([]string(nil), events...)
+}
@@ -0,0 +1,20 @@
+package initorder
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestEvents(t *testing.T) {
+	want := []string{
+		"b.go: name",
+		"a.go: greeting, after the name it needs",
+		"b.go: farewell",
+		"a.go: init",
+		"b.go: first init",
+		"b.go: second init",
+	}
+	if got := Events(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Events() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,354 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+)
+
+func init() {
+	RegisterChapter(Chapter{6, "Interfaces", []Lesson{
+		{"interfaces", "interfaces and nil interfaces", interfacesLesson, []string{"interface", "duck typing", "interface{}", "nil"}, []string{"methods"}, []string{"intermediate", "interfaces"}},
+		{"composition", "composing interfaces by embedding", compositionLesson, []string{"interface embedding", "composition", "io.ReadWriter", "QuackWalker"}, []string{"interfaces", "embedding"}, []string{"intermediate", "interfaces"}},
+		{"sorting", "sorting with sort.Interface", sortingLesson, []string{"sort", "sort.Interface", "Len", "Less", "Swap"}, []string{"interfaces", "slices"}, []string{"intermediate", "interfaces"}},
+		{"stringers", "fmt.Stringer and the String method", stringersLesson, []string{"fmt.Stringer", "String", "Println", "%v", "recursion"}, []string{"interfaces", "types"}, []string{"intermediate", "interfaces"}},
+		{"assertions", "type assertions and type switches", assertionsLesson, []string{"type assertion", "type switch", "interface{}", "json"}, []string{"interfaces"}, []string{"intermediate", "interfaces"}},
+	}})
+}
+
+// interfaces
+type Quacker interface {
+	Quack(times int)
+}
+
+// uses duck typing
+// you have the methods you qualify
+type Duck struct{}
+
+func (duck *Duck) Quack(times int) {
+	for i := 0; i < times; i++ {
+		fmt.Printf("quack")
+	}
+}
+
+// small interfaces compose into larger ones
+// the way io.ReadWriter embeds io.Reader and io.Writer
+type Walker interface {
+	Walk(steps int)
+}
+
+type QuackWalker interface {
+	Quacker
+	Walker
+}
+
+func (duck *Duck) Walk(steps int) {
+	fmt.Printf("waddled %v steps\n", steps)
+}
+
+// quacks but does not walk
+type RubberDuck struct{}
+
+func (duck RubberDuck) Quack(times int) {
+	fmt.Printf("squeaked %v times\n", times)
+}
+
+// a struct embedding an interface
+// forwards its methods to the value it holds
+// and can override some of them
+type LoudQuacker struct {
+	Quacker
+}
+
+func (loud LoudQuacker) Quack(times int) {
+	loud.Quacker.Quack(times * 2)
+}
+
+func interfacesLesson() {
+
+	// any type with a Quack method can be passed
+	trace()
+	doTheQuacking := func(quacker Quacker, times int) {
+		quacker.Quack(times)
+	}
+	duck := &Duck{}
+	doTheQuacking(duck, 3)
+	fmt.Println()
+	// Output:
+	// quackquackquack
+
+	// the empty interface
+	// everyone can play
+	trace()
+	var empty interface{}
+	empty = false
+	empty = 10
+	empty = duck
+	_ = empty
+
+	// an interface that is nil
+	trace()
+	var nilInterface Quacker = nil
+	if nilInterface != nil {
+		fmt.Println("will not execute")
+	}
+
+	// an interface that points to nil
+	// never do that
+	trace()
+	var nilDuck *Duck = nil
+	nilInterface = nilDuck
+	if nilInterface != nil {
+		fmt.Println("will execute")
+	}
+	// Output:
+	// will execute
+}
+
+type Cookie struct {
+	Size    int
+	Flavour string
+	Rating  int
+}
+
+type CookieSlice []*Cookie
+
+// any type with these
+// methods can be sorted
+type CookieBySizeSlice []*Cookie
+
+func (x CookieBySizeSlice) Len() int           { return len(x) }
+func (x CookieBySizeSlice) Less(i, j int) bool { return x[i].Size < x[j].Size }
+func (x CookieBySizeSlice) Swap(i, j int)      { x[i], x[j] = x[j], x[i] }
+
+// assembling an interface
+// from anonymous functions
+type FuncSorter struct {
+	len  func() int
+	less func(i, j int) bool
+	swap func(i, j int)
+}
+
+func (x *FuncSorter) Len() int           { return x.len() }
+func (x *FuncSorter) Less(i, j int) bool { return x.less(i, j) }
+func (x *FuncSorter) Swap(i, j int)      { x.swap(i, j) }
+
+func compositionLesson() {
+
+	// a duck has both methods
+	// so it satisfies the composite interface
+	trace()
+	var quackWalker QuackWalker = &Duck{}
+	quackWalker.Walk(3)
+	// Output:
+	// waddled 3 steps
+
+	// the composite can be used
+	// where any of its parts is expected
+	trace()
+	var walker Walker = quackWalker
+	walker.Walk(1)
+	// Output:
+	// waddled 1 steps
+
+	// a rubber duck only quacks
+	// it is a Quacker but not a QuackWalker
+	trace()
+	var quacker Quacker = RubberDuck{}
+	_, ok := quacker.(QuackWalker)
+	fmt.Printf("rubber duck walks: %v\n", ok)
+	// Output:
+	// rubber duck walks: false
+
+	// interface embedding only adds methods to implement
+	// struct embedding brings an implementation along
+	// embedding an interface in a struct
+	// forwards to whatever value it holds
+	trace()
+	loud := LoudQuacker{RubberDuck{}}
+	loud.Quack(2)
+	// Output:
+	// squeaked 4 times
+}
+
+func sortingLesson() {
+
+	// sort them cookies
+	trace()
+	cookies := CookieSlice{{10, "Chocolate", 5}, {12, "Peanuts", 4}, {8, "Almonds", 3}}
+	sort.Sort(CookieBySizeSlice(cookies))
+
+	// sort any slice by any order
+	trace()
+	sort.Sort(&FuncSorter{
+		func() int { return len(cookies) },
+		func(i, j int) bool { return cookies[i].Rating < cookies[j].Rating },
+		func(i, j int) { cookies[i], cookies[j] = cookies[j], cookies[i] },
+	})
+}
+
+// a type switch can walk dynamic values
+// such as the ones encoding/json decodes into interface{}
+// objects become map[string]interface{}
+// arrays become []interface{}
+// numbers become float64
+// and null becomes nil
+func describe(v interface{}) {
+	describeTo(os.Stdout, v, "", "")
+}
+
+func describeTo(w io.Writer, v interface{}, indent, label string) {
+	switch x := v.(type) {
+	case nil:
+		fmt.Fprintf(w, "%s%snil\n", indent, label)
+	case int:
+		fmt.Fprintf(w, "%s%sint %v\n", indent, label, x)
+	case float64:
+		fmt.Fprintf(w, "%s%sfloat64 %v\n", indent, label, x)
+	case string:
+		fmt.Fprintf(w, "%s%sstring %q\n", indent, label, x)
+	case bool:
+		fmt.Fprintf(w, "%s%sbool %v\n", indent, label, x)
+	case []interface{}:
+		fmt.Fprintf(w, "%s%sslice of %v\n", indent, label, len(x))
+		for _, element := range x {
+			describeTo(w, element, indent+"  ", "")
+		}
+	case map[string]interface{}:
+		fmt.Fprintf(w, "%s%smap of %v\n", indent, label, len(x))
+
+		// map keys are sorted
+		// for a stable output
+		keys := make([]string, 0, len(x))
+		for key := range x {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			describeTo(w, x[key], indent+"  ", key+": ")
+		}
+	default:
+		fmt.Fprintf(w, "%s%sunexpected %T\n", indent, label, x)
+	}
+}
+
+func assertionsLesson() {
+
+	// type assertions
+	trace()
+	var quacker Quacker = &Duck{}
+	if _, ok := quacker.(*Duck); ok {
+		fmt.Println("is duck")
+	}
+	// Output:
+	// is duck
+
+	// type switches
+	// cases do not fall through
+	// there is no break to write
+	trace()
+	switch x := quacker.(type) {
+	case *Duck:
+		fmt.Printf("%v is duck\n", x)
+	default:
+		fmt.Printf("%v is definitly no duck\n", x)
+	}
+	// Output:
+	// &{} is duck
+
+	// type switches over dynamic values
+	trace()
+	describe([]interface{}{1, "two", true, nil})
+
+	var decoded interface{}
+	err := json.Unmarshal([]byte(`{"name": "Fido", "legs": 4, "tricks": ["sit", "roll"], "owner": null}`), &decoded)
+	if err != nil {
+		fmt.Printf("while decoding the dog: %v\n", err)
+		return
+	}
+	describe(decoded)
+	// Output:
+	// slice of 4
+	//   int 1
+	//   string "two"
+	//   bool true
+	//   nil
+	// map of 4
+	//   legs: float64 4
+	//   name: string "Fido"
+	//   owner: nil
+	//   tricks: slice of 2
+	//     string "sit"
+	//     string "roll"
+}
+
+// fmt.Stringer is the interface
+// the fmt functions look for
+// a Temperature prints with its unit
+type Temperature float64
+
+// converting to float64 drops the String method
+// calling Sprintf("%v", t) here instead
+// would call String again and again
+// until the stack overflows
+func (t Temperature) String() string {
+	return fmt.Sprintf("%.1f°C", float64(t))
+}
+
+// a String method on the pointer
+// is only seen when printing a pointer
+type Oven struct {
+	Temperature Temperature
+}
+
+func (o *Oven) String() string {
+	return fmt.Sprintf("oven at %v", o.Temperature)
+}
+
+func stringersLesson() {
+
+	// %v and Println call the String method
+	trace()
+	temperature := Temperature(21.5)
+	fmt.Println(temperature)
+	fmt.Printf("%v, %s\n", temperature, temperature)
+	// Output:
+	// 21.5°C
+	// 21.5°C, 21.5°C
+
+	// the enum of the types lesson
+	// prints its names
+	trace()
+	fmt.Println(Vanilla, Pistachios)
+	// Output:
+	// Vanilla Pistachios
+
+	// %d skips the String method
+	// and prints the number
+	// the elements of a slice use it too
+	trace()
+	fmt.Printf("%d\n", Chocolate)
+	fmt.Printf("%v\n", []Flavor{Vanilla, Chocolate})
+	// Output:
+	// 1
+	// [Vanilla Chocolate]
+
+	// any Stringer can be passed around
+	trace()
+	var stringer fmt.Stringer = temperature
+	fmt.Println(stringer.String())
+	// Output:
+	// 21.5°C
+
+	// a pointer receiver is not in the method set
+	// of the value so the value prints as a struct
+	trace()
+	oven := Oven{180}
+	fmt.Println(oven)
+	fmt.Println(&oven)
+	// Output:
+	// {180.0°C}
+	// oven at 180.0°C
+}
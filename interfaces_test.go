@@ -0,0 +1,46 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+)
+
+func TestDescribeNestedValues(t *testing.T) {
+	var buffer bytes.Buffer
+	describeTo(&buffer, map[string]interface{}{
+		"name":   "Fido",
+		"legs":   4,
+		"tricks": []interface{}{"sit", 2.5, false},
+		"owner":  nil,
+	}, "", "")
+
+	want := `map of 4
+  legs: int 4
+  name: string "Fido"
+  owner: nil
+  tricks: slice of 3
+    string "sit"
+    float64 2.5
+    bool false
+`
+	if got := buffer.String(); got != want {
+		t.Errorf("describe printed\n%v\nwant\n%v", got, want)
+	}
+}
+
+func TestTemperatureString(t *testing.T) {
+	tests := []struct {
+		temperature Temperature
+		want        string
+	}{
+		{21.5, "21.5°C"},
+		{-3, "-3.0°C"},
+		{180.04, "180.0°C"},
+	}
+	for _, test := range tests {
+		if got := fmt.Sprint(test.temperature); got != test.want {
+			t.Errorf("Sprint(%v) = %v, want %v", float64(test.temperature), got, test.want)
+		}
+	}
+}
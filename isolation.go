@@ -0,0 +1,57 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// a hanging or panicking lesson
+// does not take the others down
+// go run . -timeout 5s
+// the goroutine of a lesson that timed out
+// cannot be stopped and keeps running in the background
+var errTimedOut = errors.New("timed out")
+
+var errNotRun = errors.New("not run: an earlier lesson timed out")
+
+func runIsolated(lesson Lesson, timeout time.Duration) error {
+	done := make(chan error, 1)
+	go func() {
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				done <- fmt.Errorf("panicked: %v", recovered)
+			}
+		}()
+		lesson.Run()
+		done <- nil
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-time.After(timeout):
+		return fmt.Errorf("%w after %v", errTimedOut, timeout)
+	}
+}
+
+// the lessons that did not finish
+// returns whether there were any
+func printFailures(w io.Writer, timings []lessonTiming) bool {
+	var failed []lessonTiming
+	for _, timing := range timings {
+		if timing.Err != nil {
+			failed = append(failed, timing)
+		}
+	}
+	if len(failed) == 0 {
+		return false
+	}
+
+	fmt.Fprintf(w, "\n%v of %v lessons did not finish\n", len(failed), len(timings))
+	for _, timing := range failed {
+		fmt.Fprintf(w, "%-15v %v\n", timing.Name, timing.Err)
+	}
+	return true
+}
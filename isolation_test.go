@@ -0,0 +1,46 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRunIsolated(t *testing.T) {
+	var tests = []struct {
+		run  func()
+		want string
+	}{
+		{func() {}, ""},
+		{func() { panic("ooops") }, "panicked: ooops"},
+		{func() { time.Sleep(time.Second) }, "timed out after 10ms"},
+	}
+	for _, test := range tests {
+		err := runIsolated(Lesson{Name: "lesson", Run: test.run}, 10*time.Millisecond)
+		got := ""
+		if err != nil {
+			got = err.Error()
+		}
+		if got != test.want {
+			t.Errorf("runIsolated() = %q, want %q", got, test.want)
+		}
+	}
+}
+
+func TestPrintFailures(t *testing.T) {
+	var buffer bytes.Buffer
+	if printFailures(&buffer, []lessonTiming{{"variables", time.Millisecond, nil}}) || buffer.Len() != 0 {
+		t.Errorf("printFailures() reported failures without any:\n%v", buffer.String())
+	}
+
+	failed := printFailures(&buffer, []lessonTiming{
+		{"variables", time.Millisecond, nil},
+		{"channels", time.Minute, errors.New("timed out after 1m0s")},
+	})
+	want := "\n1 of 2 lessons did not finish\nchannels        timed out after 1m0s\n"
+	if !failed || !strings.Contains(buffer.String(), want) {
+		t.Errorf("printFailures() = %v printing %q, want true printing %q", failed, buffer.String(), want)
+	}
+}
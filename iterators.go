@@ -0,0 +1,132 @@
+package main
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+)
+
+// a singly linked list
+// the zero value is an empty list
+type LinkedList[T any] struct {
+	head *listNode[T]
+	tail *listNode[T]
+}
+
+type listNode[T any] struct {
+	value T
+	next  *listNode[T]
+}
+
+func (l *LinkedList[T]) Push(value T) {
+	node := &listNode[T]{value: value}
+	if l.tail == nil {
+		l.head = node
+	} else {
+		l.tail.next = node
+	}
+	l.tail = node
+}
+
+// an iterator is a function
+// calling yield with each value
+// yield returns false when the loop breaks
+// and the iterator must stop right away
+func (l *LinkedList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for node := l.head; node != nil; node = node.next {
+			if !yield(node.value) {
+				return
+			}
+		}
+	}
+}
+
+// iter.Seq2 yields pairs
+// like ranging over a slice gives indexes and values
+func (l *LinkedList[T]) Indexed() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for node := l.head; node != nil; node = node.next {
+			if !yield(i, node.value) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// a filtered view of the cookies
+// nothing is copied
+// cookies are skipped as they are reached
+func (cookies CookieSlice) RatedAtLeast(rating int) iter.Seq[*Cookie] {
+	return func(yield func(*Cookie) bool) {
+		for _, cookie := range cookies {
+			if cookie.Rating >= rating && !yield(cookie) {
+				return
+			}
+		}
+	}
+}
+
+func iteratorsLesson() {
+
+	// ranging over a custom iterator
+	trace()
+	var list LinkedList[string]
+	list.Push("a")
+	list.Push("b")
+	list.Push("c")
+	for value := range list.All() {
+		fmt.Printf("value: %v\n", value)
+	}
+	// Output:
+	// value: a
+	// value: b
+	// value: c
+
+	// ranging over pairs
+	trace()
+	for i, value := range list.Indexed() {
+		fmt.Printf("%v: %v\n", i, value)
+	}
+	// Output:
+	// 0: a
+	// 1: b
+	// 2: c
+
+	// breaking out of the loop
+	// stops the iterator
+	trace()
+	for value := range list.All() {
+		if value == "b" {
+			break
+		}
+		fmt.Printf("before b: %v\n", value)
+	}
+	// Output:
+	// before b: a
+
+	// a filtered view
+	trace()
+	cookies := CookieSlice{{10, "Chocolate", 5}, {12, "Peanuts", 4}, {8, "Almonds", 3}}
+	for cookie := range cookies.RatedAtLeast(4) {
+		fmt.Printf("%v rated %v\n", cookie.Flavour, cookie.Rating)
+	}
+	// Output:
+	// Chocolate rated 5
+	// Peanuts rated 4
+
+	// the standard library speaks iterators too
+	// slices.Collect gathers the values
+	// slices.Values iterates over a slice
+	trace()
+	fmt.Printf("collected: %v\n", slices.Collect(list.All()))
+	for value := range slices.Values([]int{1, 2}) {
+		fmt.Printf("slice value: %v\n", value)
+	}
+	// Output:
+	// collected: [a b c]
+	// slice value: 1
+	// slice value: 2
+}
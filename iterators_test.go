@@ -0,0 +1,55 @@
+package main
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestLinkedList(t *testing.T) {
+	var list LinkedList[int]
+	if got := slices.Collect(list.All()); got != nil {
+		t.Errorf("empty list yielded %v", got)
+	}
+
+	for i := 1; i <= 3; i++ {
+		list.Push(i)
+	}
+	if got := slices.Collect(list.All()); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("All() yielded %v, want [1 2 3]", got)
+	}
+
+	var indexes []int
+	for i, value := range list.Indexed() {
+		if value != i+1 {
+			t.Errorf("Indexed() yielded %v at %v, want %v", value, i, i+1)
+		}
+		indexes = append(indexes, i)
+	}
+	if !reflect.DeepEqual(indexes, []int{0, 1, 2}) {
+		t.Errorf("Indexed() yielded indexes %v, want [0 1 2]", indexes)
+	}
+
+	// breaking must not make
+	// the iterator call yield again
+	count := 0
+	for range list.All() {
+		count++
+		break
+	}
+	if count != 1 {
+		t.Errorf("loop ran %v times after a break, want 1", count)
+	}
+}
+
+func TestRatedAtLeast(t *testing.T) {
+	cookies := CookieSlice{{10, "Chocolate", 5}, {12, "Peanuts", 4}, {8, "Almonds", 3}}
+
+	var flavours []string
+	for cookie := range cookies.RatedAtLeast(4) {
+		flavours = append(flavours, cookie.Flavour)
+	}
+	if !reflect.DeepEqual(flavours, []string{"Chocolate", "Peanuts"}) {
+		t.Errorf("RatedAtLeast(4) = %v, want [Chocolate Peanuts]", flavours)
+	}
+}
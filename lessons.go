@@ -0,0 +1,209 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"slices"
+	"sort"
+	"sync"
+)
+
+// a lesson demonstrates a single topic
+// keywords help finding it
+// it builds on the lessons it requires
+// tags give its difficulty and its topics
+// pack.Lesson must keep the same fields
+// so the lessons of topic packs can be converted
+type Lesson struct {
+	Name        string
+	Description string
+	Run         func()
+	Keywords    []string
+	Requires    []string
+	Tags        []string
+}
+
+// a chapter groups the lessons of a topic
+// chapters are ordered explicitly
+// since files initialize in name order
+type Chapter struct {
+	Order   int
+	Title   string
+	Lessons []Lesson
+}
+
+// chapters register themselves
+// from the init function of their file
+var registeredChapters []Chapter
+
+func RegisterChapter(chapter Chapter) {
+	checkNotRegistered(chapter.Lessons)
+	registeredChapters = append(registeredChapters, chapter)
+	sort.SliceStable(registeredChapters, func(i, j int) bool {
+		return registeredChapters[i].Order < registeredChapters[j].Order
+	})
+}
+
+// lessons registered one at a time
+// are listed in a last chapter of their own
+const miscOrder = math.MaxInt
+
+func Register(lesson Lesson) {
+	checkNotRegistered([]Lesson{lesson})
+	for i, chapter := range registeredChapters {
+		if chapter.Order == miscOrder {
+			registeredChapters[i].Lessons = append(chapter.Lessons, lesson)
+			return
+		}
+	}
+	registeredChapters = append(registeredChapters, Chapter{miscOrder, "Miscellaneous", []Lesson{lesson}})
+}
+
+// Find returns the first lesson of a name
+// so a second one would never run
+func checkNotRegistered(lessons []Lesson) {
+	names := make(map[string]bool)
+	for _, lesson := range List() {
+		names[lesson.Name] = true
+	}
+	for _, lesson := range lessons {
+		if names[lesson.Name] {
+			panic(fmt.Sprintf("lesson %v registered twice", lesson.Name))
+		}
+		names[lesson.Name] = true
+	}
+}
+
+func Chapters() []Chapter {
+	chapters := make([]Chapter, len(registeredChapters))
+	copy(chapters, registeredChapters)
+	return chapters
+}
+
+// lessons are listed in chapter order
+func List() []Lesson {
+	var lessons []Lesson
+	for _, chapter := range registeredChapters {
+		lessons = append(lessons, chapter.Lessons...)
+	}
+	return lessons
+}
+
+func Find(name string) (Lesson, bool) {
+	for _, lesson := range List() {
+		if lesson.Name == name {
+			return lesson, true
+		}
+	}
+	return Lesson{}, false
+}
+
+// the lessons having all the tags
+// go run . -tags concurrency,advanced
+func FilterByTags(lessons []Lesson, tags []string) []Lesson {
+	var filtered []Lesson
+	for _, lesson := range lessons {
+		matches := true
+		for _, tag := range tags {
+			if !slices.Contains(lesson.Tags, tag) {
+				matches = false
+			}
+		}
+		if matches {
+			filtered = append(filtered, lesson)
+		}
+	}
+	return filtered
+}
+
+// the lessons to read before a lesson
+// prerequisites first and the lesson last
+// go run . -path sorting
+func Path(name string) ([]Lesson, error) {
+	var path []Lesson
+	visited := make(map[string]bool)
+	visiting := make(map[string]bool)
+
+	var visit func(name string, requiredBy string) error
+	visit = func(name string, requiredBy string) error {
+		if visited[name] {
+			return nil
+		}
+		if visiting[name] {
+			return fmt.Errorf("lesson %v requires itself through %v", name, requiredBy)
+		}
+		lesson, ok := Find(name)
+		if !ok && requiredBy != "" {
+			return fmt.Errorf("unknown lesson %v required by %v", name, requiredBy)
+		}
+		if !ok {
+			return fmt.Errorf("unknown lesson %v", name)
+		}
+
+		visiting[name] = true
+		for _, required := range lesson.Requires {
+			if err := visit(required, name); err != nil {
+				return err
+			}
+		}
+		visiting[name] = false
+		visited[name] = true
+		path = append(path, lesson)
+		return nil
+	}
+
+	if err := visit(name, ""); err != nil {
+		return nil, err
+	}
+	return path, nil
+}
+
+func Run(name string) error {
+	lesson, ok := Find(name)
+	if !ok {
+		return fmt.Errorf("unknown lesson %v", name)
+	}
+	lesson.Run()
+	return nil
+}
+
+// capturing what a lesson prints
+// by swapping os.Stdout for a pipe
+// output written by C code bypasses os.Stdout
+// and is not captured
+// os.Stdout is global so captures run one at a time
+var captureMutex sync.Mutex
+
+func captureOutput(run func()) (string, error) {
+	captureMutex.Lock()
+	defer captureMutex.Unlock()
+
+	reader, writer, err := os.Pipe()
+	if err != nil {
+		return "", err
+	}
+	defer reader.Close()
+
+	var output bytes.Buffer
+	copied := make(chan error, 1)
+	go func() {
+		_, err := io.Copy(&output, reader)
+		copied <- err
+	}()
+
+	stdout := os.Stdout
+	os.Stdout = writer
+	func() {
+		defer func() {
+			os.Stdout = stdout
+			writer.Close()
+		}()
+		run()
+	}()
+
+	err = <-copied
+	return output.String(), err
+}
@@ -0,0 +1,180 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestRegisterAndRun(t *testing.T) {
+	registeredChaptersReal := registeredChapters
+	defer func() { registeredChapters = registeredChaptersReal }()
+	registeredChapters = nil
+
+	ran := ""
+	RegisterChapter(Chapter{2, "Second", []Lesson{
+		{"third", "the third lesson", func() { ran += "third" }, nil, nil, nil},
+	}})
+	RegisterChapter(Chapter{1, "First", []Lesson{
+		{"first", "the first lesson", func() { ran += "first" }, nil, nil, nil},
+		{"second", "the second lesson", func() { ran += "second" }, nil, nil, nil},
+	}})
+
+	chapters := Chapters()
+	if len(chapters) != 2 || chapters[0].Title != "First" || chapters[1].Title != "Second" {
+		t.Errorf("Chapters() = %v, want First and Second in order", chapters)
+	}
+
+	var names []string
+	for _, lesson := range List() {
+		names = append(names, lesson.Name)
+	}
+	if got, want := strings.Join(names, " "), "first second third"; got != want {
+		t.Errorf("List() = %v, want %v", got, want)
+	}
+
+	if err := Run("second"); err != nil {
+		t.Errorf("Run(second) failed: %v", err)
+	}
+	if ran != "second" {
+		t.Errorf("ran %q, want second", ran)
+	}
+	if err := Run("fourth"); err == nil {
+		t.Error("Run(fourth) did not fail")
+	}
+}
+
+func TestRegister(t *testing.T) {
+	registeredChaptersReal := registeredChapters
+	defer func() { registeredChapters = registeredChaptersReal }()
+	registeredChapters = nil
+
+	Register(Lesson{Name: "loose"})
+	RegisterChapter(Chapter{1, "First", []Lesson{{Name: "first"}}})
+	Register(Lesson{Name: "another"})
+
+	chapters := Chapters()
+	if len(chapters) != 2 || chapters[1].Title != "Miscellaneous" || len(chapters[1].Lessons) != 2 {
+		t.Fatalf("Chapters() = %v, want First then Miscellaneous with two lessons", chapters)
+	}
+	if _, ok := Find("another"); !ok {
+		t.Error("Find(another) did not find the registered lesson")
+	}
+}
+
+func TestRegisterTwicePanics(t *testing.T) {
+	registeredChaptersReal := registeredChapters
+	defer func() { registeredChapters = registeredChaptersReal }()
+
+	var tests = []func(){
+		func() {
+			RegisterChapter(Chapter{1, "First", []Lesson{{"first", "", func() {}, nil, nil, nil}}})
+			RegisterChapter(Chapter{2, "Second", []Lesson{{"first", "", func() {}, nil, nil, nil}}})
+		},
+		func() {
+			RegisterChapter(Chapter{1, "First", []Lesson{{Name: "first"}, {Name: "first"}}})
+		},
+		func() {
+			RegisterChapter(Chapter{1, "First", []Lesson{{Name: "first"}}})
+			Register(Lesson{Name: "first"})
+		},
+	}
+	for i, register := range tests {
+		registeredChapters = nil
+		if recovered(register) == nil {
+			t.Errorf("registering a lesson twice did not panic in case %v", i)
+		}
+	}
+}
+
+func TestFilterByTags(t *testing.T) {
+	lessons := []Lesson{
+		{Name: "a", Tags: []string{"beginner", "basics"}},
+		{Name: "b", Tags: []string{"advanced", "concurrency"}},
+		{Name: "c", Tags: []string{"beginner", "concurrency"}},
+	}
+
+	var tests = []struct {
+		tags []string
+		want string
+	}{
+		{nil, "a b c"},
+		{[]string{"beginner"}, "a c"},
+		{[]string{"concurrency", "advanced"}, "b"},
+		{[]string{"unknown"}, ""},
+	}
+	for _, test := range tests {
+		var names []string
+		for _, lesson := range FilterByTags(lessons, test.tags) {
+			names = append(names, lesson.Name)
+		}
+		if got := strings.Join(names, " "); got != test.want {
+			t.Errorf("FilterByTags(%v) = %v, want %v", test.tags, got, test.want)
+		}
+	}
+}
+
+func TestPath(t *testing.T) {
+	registeredChaptersReal := registeredChapters
+	defer func() { registeredChapters = registeredChaptersReal }()
+	registeredChapters = nil
+
+	RegisterChapter(Chapter{1, "First", []Lesson{
+		{"structs", "", func() {}, nil, nil, nil},
+		{"methods", "", func() {}, nil, []string{"structs"}, nil},
+		{"functions", "", func() {}, nil, nil, nil},
+		{"interfaces", "", func() {}, nil, []string{"methods", "functions"}, nil},
+		{"generics", "", func() {}, nil, []string{"interfaces", "functions"}, nil},
+		{"chicken", "", func() {}, nil, []string{"egg"}, nil},
+		{"egg", "", func() {}, nil, []string{"chicken"}, nil},
+		{"broken", "", func() {}, nil, []string{"missing"}, nil},
+	}})
+
+	var tests = []struct {
+		name string
+		want string
+		fail bool
+	}{
+		{"structs", "structs", false},
+		{"generics", "structs methods functions interfaces generics", false},
+		{"chicken", "", true},
+		{"broken", "", true},
+		{"unknown", "", true},
+	}
+	for _, test := range tests {
+		path, err := Path(test.name)
+		if (err != nil) != test.fail {
+			t.Errorf("Path(%v) error = %v, want failure %v", test.name, err, test.fail)
+			continue
+		}
+		var names []string
+		for _, lesson := range path {
+			names = append(names, lesson.Name)
+		}
+		if got := strings.Join(names, " "); got != test.want {
+			t.Errorf("Path(%v) = %v, want %v", test.name, got, test.want)
+		}
+	}
+}
+
+// every prerequisite must exist
+// and none can require itself
+func TestRegisteredPaths(t *testing.T) {
+	for _, lesson := range List() {
+		if _, err := Path(lesson.Name); err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func TestCaptureOutput(t *testing.T) {
+	output, err := captureOutput(func() {
+		fmt.Println("captured")
+	})
+	if err != nil {
+		t.Fatalf("captureOutput failed: %v", err)
+	}
+	if output != "captured\n" {
+		t.Errorf("captured %q, want %q", output, "captured\n")
+	}
+}
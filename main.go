@@ -1,753 +1,354 @@
 package main
 
 import (
-	"bytes"
+	"errors"
+	"flag"
 	"fmt"
-	"reflect"
-	"sort"
-	"sync"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
 	"time"
-	"unicode/utf8"
+
+	"github.com/Mathieu-Desrochers/Learning-Go/pack"
 )
 
+// inserting what the lessons print as comments
+// lessons with unstable output are skipped
+//go:generate go run ./cmd/outputgen -skip=maps,files,logging,stacks
+
+// printing a table of contents of the sections
+// go run ./cmd/toc
+
+// listing the lessons
+// go run . -list
+// running a single one
+// go run . -lesson=slices
+// browsing them interactively
+// go run . -tui
+// serving them over http
+// go run . -serve :8080
+// practicing with an exercise
+// go run . -exercise=slices-1
+// finding where a topic is demonstrated
+// go run . -search mutex
+// coloring the titles, the narration and the output
+// go run . -color=always
+// marking where each block starts
+// go run . -trace
+// suppressing the narration
+// go run . -quiet
+// timing them
+// go run . -time
+// giving up on the ones hanging
+// go run . -timeout 5s
+// reporting them as json
+// go run . -json
+// the ones after a lesson that timed out
+// are reported as not run
+// drilling a random one
+// go run . -random
+// only the ones with some tags
+// go run . -list -tags concurrency,advanced
+// finding what to read before one
+// go run . -path sorting
+// sharing one on the go playground
+// go run . -share slices
+// adding the lessons of topic packs
+// go run . -plugins dataengineering.so
+// printing the concurrency lessons in a stable order
+// go run . -deterministic
+// checking their output comments
+// go run . -check
+// exporting them as markdown
+// go run . -export-md docs/
 func main() {
-
-	// variable declarations
-	var number int = 1
-	var one, two = 1, 2
-	three := 3
-
-	// unused variables produce compilation errors
-	fmt.Println(number + one + two + three)
-
-	// arrays have a fixed length
-	var array [2]int
-	fmt.Printf("array of %v elements\n", len(array))
-
-	// array literals
-	_ = [3]int{1, 2, 3}
-	_ = [...]int{1, 2, 3, 4}
-	_ = [...]int{2: 10, 4: 20}
-
-	// slices have an auto growing length
-	// they keep track of an array and its capacity
-	var slice []int
-	fmt.Printf("slice of %v elements and a capacity for %v\n", len(slice), cap(slice))
-
-	// slice literals
-	_ = []int{}
-	_ = []int{1, 2, 3, 4}
-	_ = []int{2: 10, 4: 20}
-
-	// appending values
-	// can reallocate the array to a bigger location
-	// must be recaptured
-	slice = append(slice, 1)
-	slice = append(slice, 2)
-	slice = append(slice, 3)
-	fmt.Printf("appended slice %v\n", slice)
-
-	// selecting values
-	fmt.Printf("selected slice %v\n", array[1:])
-
-	// modifying values
-	slice[0] = 10
-	slice[1] = 20
-	slice[2] = 30
-	fmt.Printf("modified slice %v\n", slice)
-
-	// removing values
-	copy(slice[1:], slice[2:])
-	slice = slice[:len(slice)-1]
-	fmt.Printf("removed slice %v\n", slice)
-
-	// slices can be built with a
-	// predefined length and capacity
-	slice = make([]int, 5, 1000)
-	fmt.Printf("slice of %v elements and a capacity for %v\n", len(slice), cap(slice))
-
-	// selected slices are not stable
-	// changes in the source can be seen
-	// unless the source gets reallocated
-	// probably make a copy
-	source := []int{1}
-	selectedSlice := source[:]
-	source[0] = 2
-	fmt.Printf("selected slice %v\n", selectedSlice)
-	source = append(source, 0)
-	source[0] = 3
-	fmt.Printf("selected slice %v\n", selectedSlice)
-
-	// maps are hash tables
-	var nameById = make(map[int]string)
-
-	// map literals
-	_ = map[int]string{}
-	_ = map[int]string{1: "Alice", 2: "Bob"}
-
-	// setting values
-	nameById[100] = "Alice"
-	nameById[200] = "Bob"
-	nameById[300] = "Carl"
-
-	// looking up values
-	if name, ok := nameById[100]; ok {
-		fmt.Printf("name: %v\n", name)
-	}
-
-	// iterating over values
-	// order is not guaranteed
-	for id, name := range nameById {
-		fmt.Printf("id: %v, name: %v\n", id, name)
-	}
-
-	// removing values
-	delete(nameById, 300)
-
-	// strings are immutable sequences of bytes
-	greek := "some greek: Τη γλώσσα μου έδωσαν"
-
-	// the index operation returns a byte
-	fmt.Println(greek[0])
-
-	// the substring operation returns a string
-	fmt.Println(greek[5:10])
-
-	// strings can be decoded as bytes
-	greekBytes := []byte(greek)
-	fmt.Printf("greek decoded as bytes: %v\n", greekBytes)
-
-	// or as utf8 unicode code points
-	// these are named runes and are int32
-	greekRunes := []rune(greek)
-	fmt.Printf("greek decoded as runes: %v\n", greekRunes)
-
-	// fancy decoding is required to
-	// index the runes inside a string
-	rune, bytesCount := utf8.DecodeRuneInString(greek[14:])
-	runesCount := utf8.RuneCountInString(greek)
-	fmt.Printf("found rune %c spanning %v bytes\n", rune, bytesCount)
-	fmt.Printf("found %v runes\n", runesCount)
-
-	// iterating is done over runes
-	for range greek {
-	}
-
-	// efficient string building using a buffer
-	var buffer bytes.Buffer
-	buffer.WriteByte('a')
-	buffer.WriteRune('λ')
-	buffer.WriteString("yeah")
-	fmt.Println(buffer.String())
-
-	// structure definitions
-	type Employee struct {
-		EmployeeID int
-		FirstName  string
-		LastName   string
-	}
-
-	// structure literals
-	_ = Employee{1, "Alice", "Alisson"}
-	_ = Employee{FirstName: "Alice"}
-
-	// structure allocations
-	_ = new(Employee)
-	_ = &Employee{2, "Bob", "Bobson"}
-	_ = &Employee{FirstName: "Bob"}
-
-	// accessing fields
-	var employee Employee = Employee{FirstName: "A"}
-	fmt.Printf("employee first name: %v\n", employee.FirstName)
-
-	// same notation with pointers
-	var employeePointer *Employee = &employee
-	fmt.Printf("employee first name: %v\n", employeePointer.FirstName)
-
-	// structures are passed by value
-	// but are primarily used with pointers
-	type Team struct {
-		Manager   *Employee
-		Employees []*Employee
-	}
-
-	// anonymous structures
-	var point struct{ X, Y int }
-	point.X = 100
-
-	// anonymous structure literals
-	_ = struct{ X, Y, Z int }{X: 1, Y: 2, Z: 3}
-
-	// named types
-	type ShoeSize int
-	var _ ShoeSize = ShoeSize(14)
-
-	// something like an enum
-	type Flavor int32
-	const (
-		Vanilla Flavor = iota
-		Chocolate
-		Pistachios
-	)
-	var bestFlavor Flavor = Chocolate
-	fmt.Printf("bestFlavor: %v\n", bestFlavor)
-
-	// see you later
-	later()
-}
-
-// function signatures
-func noReturn() {
-}
-func oneReturn() bool {
-	return false
-}
-func multipleReturns() (bool, int) {
-	return true, 25
-}
-func bareReturns() (x, y int) {
-	x = 1
-	y = 2
-	return
-}
-
-// there is no tail call optimization
-// but we get auto-growing stacks
-func recurse(x int) {
-	if x < 1000 {
-		recurse(x + 1)
-	}
-}
-
-// error handling
-func ooops() error {
-	return fmt.Errorf("damn thing exploded")
-}
-func errorPropagation() error {
-	err := ooops()
-	if err != nil {
-		return err
-	}
-	return nil
-}
-func errorWithContext(color string) error {
-	err := ooops()
+	list := flag.Bool("list", false, "list the available lessons")
+	lessonName := flag.String("lesson", "", "run a single lesson")
+	tui := flag.Bool("tui", false, "browse the lessons interactively")
+	serve := flag.String("serve", "", "serve the lessons over http on this address")
+	exercise := flag.String("exercise", "", "check the implementation of an exercise")
+	query := flag.String("search", "", "find the lessons demonstrating a topic")
+	colorMode := flag.String("color", "auto", "color the output: auto, always or never")
+	traceFlag := flag.Bool("trace", false, "print the file and line of each block")
+	verboseFlag := flag.Bool("v", false, "print extra details")
+	quietFlag := flag.Bool("quiet", false, "print the results without the narration")
+	timed := flag.Bool("time", false, "print how long each lesson took")
+	timeout := flag.Duration("timeout", time.Minute, "give up on a lesson running longer than this")
+	jsonOutput := flag.Bool("json", false, "print the results as json")
+	random := flag.Bool("random", false, "run a random lesson")
+	tags := flag.String("tags", "", "comma separated tags the lessons must all have")
+	pathName := flag.String("path", "", "list the lessons to read before this one")
+	shareName := flag.String("share", "", "share a lesson on the go playground")
+	plugins := flag.String("plugins", "", "comma separated plugins providing more lessons")
+	deterministicFlag := flag.Bool("deterministic", false, "make goroutines print in a stable order")
+	check := flag.Bool("check", false, "compare what the lessons print with their output comments")
+	exportDir := flag.String("export-md", "", "write a markdown file per lesson to this directory")
+	seed := flag.Uint64("seed", 0, "seed of the random lesson, the current time when zero")
+	flag.Parse()
+
+	if *verboseFlag {
+		level = verbose
+	}
+	if *quietFlag {
+		level = quiet
+	}
+	deterministic = *deterministicFlag
+	tracing = *traceFlag
+
+	var pluginPaths []string
+	if *plugins != "" {
+		pluginPaths = strings.Split(*plugins, ",")
+	}
+	if err := loadPacks(pack.Providers(), pluginPaths); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	enabled, err := colorEnabled(*colorMode, os.Stdout)
 	if err != nil {
-		return fmt.Errorf("while trying to paint %s: %v", color, err)
-	}
-	return nil
-}
-
-// functions as values
-func addNumbers(x, y int) int {
-	return x + y
-}
-
-func later() {
-
-	// returns
-	noReturn()
-	_ = oneReturn()
-	_, _ = multipleReturns()
-	_, _ = bareReturns()
-
-	// functions as values
-	var functionAsValue func(int, int) int = addNumbers
-	fmt.Println(functionAsValue(1, 2))
-
-	// anonymous functions
-	plusOne := func(x int) int { return x + 1 }
-	fmt.Println(plusOne(1))
-
-	// closures
-	someNumber := 25
-	plusTwo := func() int { return someNumber + 2 }
-	fmt.Println(plusTwo())
-
-	// but by reference
-	someNumber = 50
-	fmt.Println(plusTwo())
-
-	// leading to weird patterns
-	// where closed values need to be copied
-	plusThreeNumber := someNumber
-	plusThree := func() int { return plusThreeNumber + 3 }
-	someNumber = 75
-	fmt.Println(plusThree())
-
-	// variadic functions
-	bigCompute := func(values ...int) int {
-		return len(values)
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
 	}
-	bigComputeValues := []int{1, 2, 3}
-	fmt.Println(bigCompute(1, 2, 3))
-	fmt.Println(bigCompute(bigComputeValues...))
+	colored = enabled
 
-	// deferred function calls
-	doStuff := func() {
-		fmt.Println("enter")
-		defer fmt.Println("executed when the function exits")
-		{
-			defer fmt.Println("not when a block exits")
+	if *query != "" {
+		for _, match := range search(List(), *query) {
+			fmt.Printf("%-15v %v:%v %v\n", match.Lesson.Name, filepath.Base(match.File), match.Line, match.Text)
 		}
-		fmt.Println("exit")
-	}
-	doStuff()
-
-	// panicking
-	ohNoes := func() {
-		panic("we are screwed")
-	}
-
-	// recovering
-	keepCalm := func() {
-		defer func() {
-			whatNow := recover()
-			fmt.Println(whatNow)
-		}()
-		ohNoes()
-		fmt.Println("too bad won't execute")
-	}
-	keepCalm()
-
-	laterr()
-}
-
-type Animal struct {
-	LegsCount int
-}
-
-// methods are attached to a receiver type
-func (a *Animal) CanQuack() bool {
-	return false
-}
-
-// receivers are primarily pointers
-// to allow state mutations
-func (a *Animal) GrowLeg() {
-	a.LegsCount++
-}
-
-func laterr() {
-
-	// methods
-	animal := &Animal{4}
-	fmt.Println(animal.CanQuack())
-
-	// structure embedding
-	type Dog struct {
-		Animal
-		GoodBoyName string
-	}
-
-	// the structure gains all
-	// the members of the embedded one
-	fido := &Dog{Animal{4}, "Fido"}
-	fmt.Printf("legs count: %v\n", fido.LegsCount)
-	fmt.Printf("good boy name: %v\n", fido.GoodBoyName)
-
-	// including its attached methods
-	fido.GrowLeg()
-
-	// the embedded structure
-	// can be accessed explicitly
-	var _ *Animal = &fido.Animal
-
-	// converting from method to a function
-	// taking the receiver as first parameter
-	methodExpression := (*Animal).GrowLeg
-	methodExpression(animal)
-
-	// converting from method to a function
-	// with the receiver already bound
-	methodValue := animal.GrowLeg
-	methodValue()
-
-	laterrr()
-}
-
-// encapsulation
-// members starting with a lower cased letter
-// are only visible inside their package
-type Cake struct {
-	hugeCaloriesCount int
-}
-
-// getters and setters
-func (cake *Cake) HugeCaloriesCount() int {
-	return cake.hugeCaloriesCount
-}
-func (cake *Cake) SetHugeCaloriesCount(value int) {
-	cake.hugeCaloriesCount = value
-}
-
-// interfaces
-type Quacker interface {
-	Quack(times int)
-}
-
-// uses duck typing
-// you have the methods you qualify
-type Duck struct{}
-
-func (duck *Duck) Quack(times int) {
-	for i := 0; i < times; i++ {
-		fmt.Printf("quack")
-	}
-}
-
-func laterrr() {
-
-	// visible inside this package
-	var hugeCake = &Cake{100000}
-	_ = hugeCake.hugeCaloriesCount
-
-	// any type with a Quack method can be passed
-	doTheQuacking := func(quacker Quacker, times int) {
-		quacker.Quack(times)
-	}
-	duck := &Duck{}
-	doTheQuacking(duck, 3)
-
-	// the empty interface
-	// everyone can play
-	var empty interface{}
-	empty = false
-	empty = 10
-	empty = duck
-	_ = empty
-
-	// an interface that is nil
-	var nilInterface Quacker = nil
-	if nilInterface != nil {
-		fmt.Println("will not execute")
-	}
-
-	// an interface that points to nil
-	// never do that
-	var nilDuck *Duck = nil
-	nilInterface = nilDuck
-	if nilInterface != nil {
-		fmt.Println("will execute")
+		return
 	}
 
-	laterrrr()
-}
-
-type Cookie struct {
-	Size    int
-	Flavour string
-	Rating  int
-}
-
-type CookieSlice []*Cookie
-
-// any type with these
-// methods can be sorted
-type CookieBySizeSlice []*Cookie
-
-func (x CookieBySizeSlice) Len() int           { return len(x) }
-func (x CookieBySizeSlice) Less(i, j int) bool { return x[i].Size < x[j].Size }
-func (x CookieBySizeSlice) Swap(i, j int)      { x[i], x[j] = x[j], x[i] }
-
-// assembling an interface
-// from anonymous functions
-type FuncSorter struct {
-	len  func() int
-	less func(i, j int) bool
-	swap func(i, j int)
-}
-
-func (x *FuncSorter) Len() int           { return x.len() }
-func (x *FuncSorter) Less(i, j int) bool { return x.less(i, j) }
-func (x *FuncSorter) Swap(i, j int)      { x.swap(i, j) }
-
-func laterrrr() {
-
-	// sort them cookies
-	cookies := CookieSlice{{10, "Chocolate", 5}, {12, "Peanuts", 4}, {8, "Almonds", 3}}
-	sort.Sort(CookieBySizeSlice(cookies))
-
-	// sort any slice by any order
-	sort.Sort(&FuncSorter{
-		func() int { return len(cookies) },
-		func(i, j int) bool { return cookies[i].Rating < cookies[j].Rating },
-		func(i, j int) { cookies[i], cookies[j] = cookies[j], cookies[i] },
-	})
-
-	// type assertions
-	var quacker Quacker = &Duck{}
-	if _, ok := quacker.(*Duck); ok {
-		fmt.Println("is duck")
-	}
-
-	// type switches
-	switch x := quacker.(type) {
-	case *Duck:
-		fmt.Printf("%v is duck\n", x)
-		break
-	default:
-		fmt.Printf("%v is definitly no duck\n", x)
-		break
-	}
-
-	takeNap := func() {
-		time.Sleep(100 * time.Millisecond)
-	}
-
-	// functions invoked with
-	// go are executed concurrently
-	go takeNap()
-	go takeNap()
-	go takeNap()
-
-	// goroutines communicate by
-	// exchanging messages over channels
-	channel := make(chan int)
-
-	// both the sender and the receiver are blocked
-	// until a message is exchanged
-	sender := func() {
-		fmt.Println("sending value 1")
-		channel <- 1
-	}
-
-	receiver := func() {
-		value := <-channel
-		fmt.Printf("received value %v\n", value)
+	if *exercise != "" {
+		if err := RunExercise(*exercise); err != nil {
+			fmt.Printf("fail: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Println("pass")
+		return
 	}
 
-	go sender()
-	go receiver()
-	time.Sleep(1 * time.Second)
-
-	// a channel can be closed to signal
-	// no more messages will be sent
-	sender = func() {
-		fmt.Println("closing channel")
-		close(channel)
+	if *pathName != "" {
+		path, err := Path(*pathName)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(2)
+		}
+		for i, lesson := range path {
+			fmt.Printf("%2v. %-15v %v\n", i+1, lesson.Name, lesson.Description)
+		}
+		return
 	}
 
-	receiver = func() {
-		if _, ok := <-channel; !ok {
-			fmt.Println("channel was closed")
+	if *shareName != "" {
+		lesson, ok := Find(*shareName)
+		if !ok {
+			fmt.Fprintf(os.Stderr, "unknown lesson %v, see -list\n", *shareName)
+			os.Exit(2)
+		}
+		url, err := shareLesson(lesson)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		fmt.Println(url)
+		return
+	}
+
+	// the output comments were generated
+	// with the goroutines taking turns
+	if *check {
+		deterministic = true
+		failed := false
+		for _, lesson := range List() {
+			diff, checked, err := checkLesson(lesson)
+			switch {
+			case err != nil:
+				failed = true
+				fmt.Printf("FAIL %v: %v\n", lesson.Name, err)
+			case !checked:
+				fmt.Printf("skip %v: no output comments\n", lesson.Name)
+			case diff != "":
+				failed = true
+				fmt.Printf("FAIL %v: output differs from the comments\n%v", lesson.Name, diff)
+			default:
+				fmt.Printf("ok   %v\n", lesson.Name)
+			}
 		}
+		if failed {
+			os.Exit(1)
+		}
+		return
 	}
 
-	go sender()
-	go receiver()
-	time.Sleep(1 * time.Second)
-	channel = make(chan int)
-
-	// loop of messages
-	// the range automatically breaks
-	// when the channel closes
-	sender = func() {
-		for i := 0; i < 5; i++ {
-			fmt.Printf("sending value %v\n", i)
-			channel <- i
+	if *exportDir != "" {
+		if err := exportMarkdown(*exportDir, List()); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
 		}
-		close(channel)
+		return
 	}
 
-	receiver = func() {
-		for value := range channel {
-			fmt.Printf("received value %v\n", value)
+	if *serve != "" {
+		fmt.Printf("serving the lessons on %v\n", *serve)
+		if err := http.ListenAndServe(*serve, newLessonServer()); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
 		}
-		fmt.Println("channel was closed")
+		return
 	}
 
-	go sender()
-	go receiver()
-	time.Sleep(1 * time.Second)
-	channel = make(chan int)
-
-	// looping concurrently
-	// and receiving the results
-	workItems := []int{1, 2, 3, 4}
+	if *tui {
+		if err := browse(List()); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	// completed lessons are remembered
+	// a progress that cannot be loaded
+	// or saved is reported but not fatal
+	progress := loadProgress()
+
+	var filter []string
+	if *tags != "" {
+		filter = strings.Split(*tags, ",")
+	}
+
+	if *list {
+		for _, chapter := range Chapters() {
+			lessons := FilterByTags(chapter.Lessons, filter)
+			if len(lessons) == 0 {
+				continue
+			}
+			fmt.Printf("%v. %v\n", chapter.Order, chapter.Title)
+			for _, lesson := range lessons {
+				done := " "
+				if progress.Done(lesson.Name) {
+					done = "x"
+				}
+				fmt.Printf("    [%v] %-15v %v\n", done, lesson.Name, lesson.Description)
+			}
+		}
+		fmt.Printf("%v%% completed\n", progress.Percent(List()))
 
-	for _, workItem := range workItems {
-		go func(capturedWorkItem int) {
-			fmt.Printf("sending result %v\n", capturedWorkItem)
-			channel <- capturedWorkItem
-		}(workItem)
+		fmt.Println("exercises")
+		for _, exercise := range ListExercises() {
+			fmt.Printf("    %-19v %v\n", exercise.Name, exercise.Prompt)
+		}
+		return
 	}
 
-	for range workItems {
-		result := <-channel
-		fmt.Printf("received result %v\n", result)
+	lessons := FilterByTags(List(), filter)
+	if len(lessons) == 0 {
+		fmt.Fprintf(os.Stderr, "no lesson tagged %v, see -list\n", *tags)
+		os.Exit(2)
 	}
-
-	close(channel)
-	channel = make(chan int)
-
-	// controlling concurrency
-	// with a fixed number of receivers
-	sender = func() {
-		for i := 0; i < 5; i++ {
-			channel <- i
+	if *lessonName != "" {
+		lesson, ok := Find(*lessonName)
+		if !ok {
+			fmt.Fprintf(os.Stderr, "unknown lesson %v, see -list\n", *lessonName)
+			os.Exit(2)
 		}
-		close(channel)
+		lessons = []Lesson{lesson}
 	}
-
-	indexedReceiver := func(index int) {
-		for value := range channel {
-			fmt.Printf("%v received value %v\n", index, value)
+	if *random {
+		if *seed == 0 {
+			*seed = uint64(time.Now().UnixNano())
+		}
+		lesson, _ := pickRandom(lessons, *seed)
+		if !*jsonOutput {
+			fmt.Printf("drilling %v with -seed %v\n", lesson.Name, *seed)
+		}
+		lessons = []Lesson{lesson}
+	}
+
+	// lessons that panic or time out
+	// are reported after the others ran
+	// and are not marked as completed
+	var timings []lessonTiming
+	var results []lessonResult
+	failed := false
+	stdout := os.Stdout
+	if *jsonOutput {
+		discarded, err := discardStdout()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "while discarding the output: %v\n", err)
+			os.Exit(1)
+		}
+		stdout = discarded
+	}
+
+	// the goroutine of a lesson that timed out keeps printing
+	// so the lessons after it are reported as not run
+	// instead of getting its output mixed with theirs
+	abandoned := false
+	for _, lesson := range lessons {
+		if abandoned {
+			failed = true
+			if *jsonOutput {
+				results = append(results, lessonResult{Lesson: lesson.Name, Error: errNotRun.Error()})
+			} else {
+				timings = append(timings, lessonTiming{lesson.Name, 0, errNotRun})
+			}
+			continue
 		}
-	}
-
-	go sender()
-	go indexedReceiver(1)
-	go indexedReceiver(2)
-	time.Sleep(1 * time.Second)
-
-	// selecting from multiple channels
-	// blocks until one of them receives a message
-	channel1 := make(chan int)
-	channel2 := make(chan int)
 
-	sender = func() {
-		channel2 <- 1
-	}
+		if *jsonOutput {
+			result, timedOut := runForResult(lesson, *timeout)
+			results = append(results, result)
+			if result.Error != "" {
+				failed = true
+			} else {
+				progress.MarkDone(lesson.Name)
+			}
+			abandoned = timedOut
+			continue
+		}
 
-	receiver = func() {
-		select {
-		case value := <-channel1:
-			fmt.Printf("received %v on channel1\n", value)
-			break
-		case value := <-channel2:
-			fmt.Printf("received %v on channel2\n", value)
-			break
+		printTitle(lesson)
+		timing := timeLesson(lesson, *timeout)
+		fmt.Print(paint(resetColor))
+		timings = append(timings, timing)
+		if timing.Err == nil {
+			progress.MarkDone(lesson.Name)
+		}
+		if errors.Is(timing.Err, errTimedOut) {
+			abandoned = true
+			if _, err := discardStdout(); err != nil {
+				fmt.Fprintf(os.Stderr, "while discarding the output: %v\n", err)
+			}
 		}
 	}
-
-	go sender()
-	go receiver()
-	time.Sleep(1 * time.Second)
-
-	// adding a default branch
-	// makes select non blocking
-	receiver = func() {
-		select {
-		case _ = <-channel1:
-			break
-		default:
-			fmt.Println("received nothing")
-			break
+	if *timed {
+		printTimings(stdout, timings)
+	}
+	if *jsonOutput {
+		if err := printResults(stdout, results); err != nil {
+			fmt.Fprintln(os.Stderr, err)
 		}
 	}
-
-	go receiver()
-	time.Sleep(1 * time.Second)
-	close(channel1)
-	close(channel2)
-
-	// channel types can be used to
-	// enforce the message directions
-	var _ chan<- int = channel
-	var _ <-chan int = channel
-
-	// a buffer size can be set on the channel
-	// the sender blocks only when the buffer is full
-	channel = make(chan int, 2)
-	close(channel)
-
-	// a mutex allows one goroutine at a time
-	// must be used to protect shared state
-	var balanceMutex sync.Mutex
-	balance := 100
-
-	deposit := func(amount int) {
-		balanceMutex.Lock()
-		defer balanceMutex.Unlock()
-		balance += amount
+	if printFailures(os.Stderr, timings) {
+		failed = true
 	}
 
-	go deposit(15)
-	go deposit(500)
-	time.Sleep(1 * time.Second)
-
-	// a read-write mutex allows
-	// one writer or multiple readers
-	var readWriteMutex sync.RWMutex
-	coins := 0
-
-	moreCoins := func(count int) {
-		readWriteMutex.Lock()
-		defer readWriteMutex.Unlock()
-		coins += count
+	if err := progress.Save(); err != nil {
+		fmt.Fprintf(os.Stderr, "while saving the progress: %v\n", err)
 	}
-
-	howManyCoins := func() int {
-		readWriteMutex.RLock()
-		defer readWriteMutex.RUnlock()
-		return coins
+	if failed {
+		os.Exit(1)
 	}
+}
 
-	go moreCoins(15)
-	go howManyCoins()
-	go howManyCoins()
-	time.Sleep(1 * time.Second)
-
-	// a read-write mutex
-	// for the lazy initialization
-	// of a read-only state is provided
-	var onceMutex sync.Once
-	var lazyInitializedValue int
-
-	getLazyInitializedValue := func() int {
-		onceMutex.Do(func() { lazyInitializedValue = 10 + 2/7 - 16 })
-		return lazyInitializedValue
+func loadProgress() *progress {
+	path, err := progressPath()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "while locating the progress: %v\n", err)
 	}
-
-	go getLazyInitializedValue()
-	go getLazyInitializedValue()
-	time.Sleep(1 * time.Second)
-
-	// running a program with the race detector
-	// go run -race
-
-	// using reflection
-	reflection := func(somethingA, somethingB interface{}) {
-
-		// getting something's type
-		typeA := reflect.TypeOf(somethingA).Elem()
-		fmt.Printf("somethingA is a %v\n", typeA.Kind())
-
-		typeB := reflect.TypeOf(somethingB).Elem()
-		fmt.Printf("somethingB is a %v\n", typeB)
-
-		// getting something's value
-		valueA := reflect.ValueOf(somethingA).Elem().Int()
-		fmt.Printf("somethingA is %v\n", valueA)
-
-		valueB := reflect.ValueOf(somethingB).Elem()
-		for i := 0; i < valueB.NumField(); i++ {
-			fmt.Printf("somethingB.%v is %v\n", valueB.Type().Field(i).Name, valueB.Field(i))
-		}
-
-		// setting something's value
-		reflect.ValueOf(somethingA).Elem().Set(reflect.ValueOf(2))
-		reflect.ValueOf(somethingB).Elem().FieldByName("X").Set(reflect.ValueOf(10))
-
-		// accessing field tags
-		tag := reflect.ValueOf(somethingB).Elem().Type().Field(0).Tag.Get("color")
-		fmt.Printf("somethingB.X has color %v\n", tag)
+	progress := newProgress(path)
+	if err := progress.Load(); err != nil {
+		fmt.Fprintf(os.Stderr, "while loading the progress: %v\n", err)
 	}
-
-	number := 1
-	structure := struct {
-		X int `color:"red"`
-		Y int `color:"blue"`
-	}{1, 2}
-
-	// setting values must be done through a pointer
-	// always use them for consistency
-	reflection(&number, &structure)
-
-	fmt.Printf("number is now %v\n", number)
-	fmt.Printf("structure is now %v\n", structure)
-
-	// calling C code
-	Print("Hello")
+	return progress
 }
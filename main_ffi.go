@@ -1,15 +1,27 @@
+//go:build cgo
+
 package main
 
-// #include <stdio.h>
 // #include <stdlib.h>
+// #include <string.h>
 import "C"
-import "unsafe"
+import (
+	"fmt"
+	"unsafe"
+)
 
 // calling C code
+// C writes to file descriptor 1 directly
+// bypassing os.Stdout and whatever replaced it
+// so the string makes a trip through C
+// and is printed from Go
 func Print(s string) {
 	cs := C.CString(s)
 	defer func() { C.free(unsafe.Pointer(cs)) }()
 
-	C.fputs(cs, (*C.FILE)(C.stdout))
-	C.fflush((*C.FILE)(C.stdout))
+	length := C.strlen(cs)
+	fmt.Print(C.GoStringN(cs, C.int(length)))
 }
+
+// tells the build-tags lesson which file was compiled
+const cgoEnabled = true
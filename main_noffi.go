@@ -0,0 +1,13 @@
+//go:build !cgo
+
+package main
+
+import "fmt"
+
+// without cgo there is no C to call
+// CGO_ENABLED=0 go build
+func Print(s string) {
+	fmt.Print(s)
+}
+
+const cgoEnabled = false
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// exporting the lessons as markdown
+// go run . -export-md docs/
+// one file per lesson where each section
+// is its comment followed by its fenced code
+// and what the code printed
+func exportMarkdown(dir string, lessons []Lesson) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	for _, lesson := range lessons {
+		code, err := lessonSource(lesson)
+		if err != nil {
+			return err
+		}
+
+		// lessons with unstable output have no output comments
+		// so what they print is captured instead
+		output := ""
+		if !strings.Contains(code.Source, "// Output:") {
+			output, err = captureOutput(lesson.Run)
+			if err != nil {
+				return fmt.Errorf("while running lesson %v: %w", lesson.Name, err)
+			}
+		}
+
+		path := filepath.Join(dir, lesson.Name+".md")
+		if err := os.WriteFile(path, []byte(lessonMarkdown(lesson, code, output)), 0644); err != nil {
+			return err
+		}
+		fmt.Printf("wrote %v\n", path)
+	}
+	return nil
+}
+
+func lessonMarkdown(lesson Lesson, code lessonCode, output string) string {
+	var markdown strings.Builder
+	fmt.Fprintf(&markdown, "# %v\n\n%v\n", lesson.Name, lesson.Description)
+
+	// leaving out the signature, the closing brace
+	// and the trace calls
+	// then removing the indentation of the body
+	lines := strings.Split(code.Source, "\n")
+	if len(lines) > 2 {
+		lines = lines[1 : len(lines)-1]
+	}
+	var body []string
+	for _, line := range lines {
+		if strings.TrimSpace(line) != "trace()" {
+			body = append(body, strings.TrimPrefix(line, "\t"))
+		}
+	}
+	lines = body
+
+	starts := sectionStarts(lines)
+	for i, start := range starts {
+		end := len(lines)
+		if i+1 < len(starts) {
+			end = starts[i+1]
+		}
+		writeSection(&markdown, lines[start:end])
+	}
+
+	if output != "" {
+		writeFence(&markdown, "", "Output:", strings.Split(strings.TrimSuffix(output, "\n"), "\n"))
+	}
+	return markdown.String()
+}
+
+// the leading comment becomes a paragraph
+// output comments are fenced apart from the code
+func writeSection(markdown *strings.Builder, lines []string) {
+	i := 0
+	var paragraph []string
+	for ; i < len(lines) && strings.HasPrefix(lines[i], "//"); i++ {
+		paragraph = append(paragraph, uncomment(lines[i]))
+	}
+	if len(paragraph) > 0 {
+		fmt.Fprintf(markdown, "\n%v\n", strings.Join(paragraph, "\n"))
+	}
+
+	var code []string
+	for ; i < len(lines); i++ {
+		if strings.TrimSpace(lines[i]) != "// Output:" {
+			code = append(code, lines[i])
+			continue
+		}
+		writeFence(markdown, "go", "", code)
+		code = nil
+
+		var output []string
+		for i++; i < len(lines) && strings.HasPrefix(strings.TrimSpace(lines[i]), "//"); i++ {
+			output = append(output, uncomment(strings.TrimSpace(lines[i])))
+		}
+		writeFence(markdown, "", "Output:", output)
+		i--
+	}
+	writeFence(markdown, "go", "", code)
+}
+
+func uncomment(line string) string {
+	return strings.TrimPrefix(strings.TrimPrefix(line, "//"), " ")
+}
+
+// blank lines around the fenced lines are dropped
+// and nothing is written when none are left
+func writeFence(markdown *strings.Builder, language string, title string, lines []string) {
+	for len(lines) > 0 && strings.TrimSpace(lines[0]) == "" {
+		lines = lines[1:]
+	}
+	for len(lines) > 0 && strings.TrimSpace(lines[len(lines)-1]) == "" {
+		lines = lines[:len(lines)-1]
+	}
+	if len(lines) == 0 {
+		return
+	}
+	if title != "" {
+		fmt.Fprintf(markdown, "\n%v\n", title)
+	}
+	fmt.Fprintf(markdown, "\n```%v\n%v\n```\n", language, strings.Join(lines, "\n"))
+}
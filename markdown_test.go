@@ -0,0 +1,34 @@
+package main
+
+import "testing"
+
+func TestLessonMarkdown(t *testing.T) {
+	code := lessonCode{Source: `func lesson() {
+
+	// first section
+	// on two lines
+	trace()
+	fmt.Println("a")
+	// Output:
+	// a
+
+	// second section
+	fmt.Println("b")
+
+	// printing more
+	fmt.Println("c")
+}`}
+
+	want := "# lesson\n\nthe lesson\n" +
+		"\nfirst section\non two lines\n" +
+		"\n```go\nfmt.Println(\"a\")\n```\n" +
+		"\nOutput:\n\n```\na\n```\n" +
+		"\nsecond section\n" +
+		"\n```go\nfmt.Println(\"b\")\n```\n" +
+		"\nprinting more\n" +
+		"\n```go\nfmt.Println(\"c\")\n```\n" +
+		"\nOutput:\n\n```\nb\nc\n```\n"
+	if got := lessonMarkdown(Lesson{Name: "lesson", Description: "the lesson"}, code, "b\nc\n"); got != want {
+		t.Errorf("lessonMarkdown() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,332 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+	"time"
+)
+
+// permissions packed into the bits of a byte
+// the way file modes are
+const (
+	readBit    uint8 = 0b100
+	writeBit   uint8 = 0b010
+	executeBit uint8 = 0b001
+)
+
+func bitsLesson() {
+
+	// %b prints the binary representation
+	// %08b pads it to 8 digits
+	trace()
+	var a, b uint8 = 0b1100, 0b1010
+	fmt.Printf("a:      %08b\n", a)
+	fmt.Printf("b:      %08b\n", b)
+	// Output:
+	// a:      00001100
+	// b:      00001010
+
+	// and, or, xor
+	// and not clears the bits set in b
+	trace()
+	fmt.Printf("a & b:  %08b\n", a&b)
+	fmt.Printf("a | b:  %08b\n", a|b)
+	fmt.Printf("a ^ b:  %08b\n", a^b)
+	fmt.Printf("a &^ b: %08b\n", a&^b)
+	// Output:
+	// a & b:  00001000
+	// a | b:  00001110
+	// a ^ b:  00000110
+	// a &^ b: 00000100
+
+	// unary ^ flips every bit
+	// there is no ~ operator
+	trace()
+	fmt.Printf("^a:     %08b\n", ^a)
+	// Output:
+	// ^a:     11110011
+
+	// shifts multiply and divide by powers of 2
+	// bits shifted out are lost
+	trace()
+	fmt.Printf("a << 2: %08b\n", a<<2)
+	fmt.Printf("a >> 2: %08b\n", a>>2)
+	fmt.Printf("a << 6: %08b\n", a<<6)
+	// Output:
+	// a << 2: 00110000
+	// a >> 2: 00000011
+	// a << 6: 00000000
+
+	// setting, clearing and testing flags
+	trace()
+	mode := readBit | writeBit
+	mode &^= writeBit
+	mode |= executeBit
+	fmt.Printf("mode: %03b, can write: %v\n", mode, mode&writeBit != 0)
+	// Output:
+	// mode: 101, can write: false
+
+	// math/bits counts and rotates
+	// without hand written loops
+	trace()
+	var c uint8 = 0b00010110
+	fmt.Printf("ones: %v\n", bits.OnesCount8(c))
+	fmt.Printf("leading zeros: %v\n", bits.LeadingZeros8(c))
+	fmt.Printf("trailing zeros: %v\n", bits.TrailingZeros8(c))
+	fmt.Printf("rotate left 4: %08b\n", bits.RotateLeft8(c, 4))
+	fmt.Printf("rotate right 2: %08b\n", bits.RotateLeft8(c, -2))
+	fmt.Printf("length: %v\n", bits.Len8(c))
+	// Output:
+	// ones: 3
+	// leading zeros: 3
+	// trailing zeros: 1
+	// rotate left 4: 01100001
+	// rotate right 2: 10000101
+	// length: 5
+}
+
+func integersLesson() {
+
+	// sized integers and their ranges
+	// int and uint are 64 bits on 64 bits platforms
+	trace()
+	fmt.Printf("int8:   %v to %v\n", math.MinInt8, math.MaxInt8)
+	fmt.Printf("uint8:  %v to %v\n", 0, math.MaxUint8)
+	fmt.Printf("int16:  %v to %v\n", math.MinInt16, math.MaxInt16)
+	fmt.Printf("uint16: %v to %v\n", 0, math.MaxUint16)
+	fmt.Printf("int32:  %v to %v\n", math.MinInt32, math.MaxInt32)
+	fmt.Printf("uint32: %v to %v\n", 0, uint32(math.MaxUint32))
+	fmt.Printf("int64:  %v to %v\n", math.MinInt64, math.MaxInt64)
+	fmt.Printf("uint64: %v to %v\n", 0, uint64(math.MaxUint64))
+	// Output:
+	// int8:   -128 to 127
+	// uint8:  0 to 255
+	// int16:  -32768 to 32767
+	// uint16: 0 to 65535
+	// int32:  -2147483648 to 2147483647
+	// uint32: 0 to 4294967295
+	// int64:  -9223372036854775808 to 9223372036854775807
+	// uint64: 0 to 18446744073709551615
+
+	// overflowing wraps around silently
+	// no panic and no error
+	trace()
+	var small int8 = math.MaxInt8
+	small++
+	var unsigned uint8 = 0
+	unsigned--
+	fmt.Printf("max int8 + 1: %v\n", small)
+	fmt.Printf("uint8 0 - 1: %v\n", unsigned)
+	// Output:
+	// max int8 + 1: -128
+	// uint8 0 - 1: 255
+
+	// constants are checked at compile time
+	// var tooBig int8 = 128 does not compile
+	trace()
+	const big = 1 << 100
+	fmt.Printf("big >> 98: %v\n", big>>98)
+	// Output:
+	// big >> 98: 4
+
+	// converting keeps the bits
+	// not the value
+	trace()
+	negative := int8(-1)
+	fmt.Printf("uint8(-1): %v\n", uint8(negative))
+	large := 300
+	fmt.Printf("uint8(300): %v\n", uint8(large))
+	byteValue := uint8(200)
+	fmt.Printf("int8(200): %v\n", int8(byteValue))
+	// Output:
+	// uint8(-1): 255
+	// uint8(300): 44
+	// int8(200): -56
+
+	// counting down with an unsigned integer
+	// never goes below zero
+	// i >= 0 is always true and the loop never ends
+	trace()
+	items := []string{"a", "b", "c"}
+	for i := uint(len(items)); i > 0; i-- {
+		fmt.Printf("item: %v\n", items[i-1])
+	}
+	// Output:
+	// item: c
+	// item: b
+	// item: a
+
+	// integer division truncates toward zero
+	// the remainder takes the sign of the dividend
+	trace()
+	fmt.Printf("7 / 2 = %v, 7 %% 2 = %v\n", 7/2, 7%2)
+	fmt.Printf("-7 / 2 = %v, -7 %% 2 = %v\n", -7/2, -7%2)
+	fmt.Printf("7 / -2 = %v, 7 %% -2 = %v\n", 7/-2, 7%-2)
+	// Output:
+	// 7 / 2 = 3, 7 % 2 = 1
+	// -7 / 2 = -3, -7 % 2 = -1
+	// 7 / -2 = -3, 7 % -2 = 1
+
+	// a modulo that is never negative
+	// for wrapping around a ring
+	trace()
+	fmt.Printf("positive modulo: %v\n", positiveModulo(-7, 3))
+	// Output:
+	// positive modulo: 2
+}
+
+// the remainder shifted into 0 to n-1
+func positiveModulo(a, n int) int {
+	return ((a % n) + n) % n
+}
+
+// floats are rarely exactly equal
+// after some arithmetic
+// comparing within a tolerance relative to their size
+func almostEqual(a, b, tolerance float64) bool {
+	if a == b {
+		return true
+	}
+	return math.Abs(a-b) <= tolerance*math.Max(math.Abs(a), math.Abs(b))
+}
+
+func floatsLesson() {
+
+	// 0.1 and 0.2 have no exact binary representation
+	// their sum is not exactly 0.3
+	trace()
+	a, b := 0.1, 0.2
+	fmt.Printf("0.1 + 0.2 == 0.3: %v\n", a+b == 0.3)
+	fmt.Printf("0.1 + 0.2 = %.17f\n", a+b)
+	// Output:
+	// 0.1 + 0.2 == 0.3: false
+	// 0.1 + 0.2 = 0.30000000000000004
+
+	// comparing with a tolerance
+	trace()
+	fmt.Printf("almost equal: %v\n", almostEqual(a+b, 0.3, 1e-9))
+	// Output:
+	// almost equal: true
+
+	// constants are exact at compile time
+	// the rounding happens on assignment
+	trace()
+	fmt.Printf("constant 0.1 + 0.2 == 0.3: %v\n", 0.1+0.2 == 0.3)
+	// Output:
+	// constant 0.1 + 0.2 == 0.3: true
+
+	// dividing by zero gives infinities
+	// not a panic like with integers
+	trace()
+	zero := 0.0
+	positiveInfinity := 1 / zero
+	fmt.Printf("1 / 0: %v, -1 / 0: %v\n", positiveInfinity, -1/zero)
+	fmt.Printf("is infinite: %v\n", math.IsInf(positiveInfinity, 1))
+	// Output:
+	// 1 / 0: +Inf, -1 / 0: -Inf
+	// is infinite: true
+
+	// NaN is not equal to anything
+	// not even itself
+	// it must be tested with math.IsNaN
+	trace()
+	notANumber := zero / zero
+	fmt.Printf("NaN == NaN: %v\n", notANumber == notANumber)
+	fmt.Printf("is NaN: %v\n", math.IsNaN(notANumber))
+	fmt.Printf("inf - inf: %v\n", positiveInfinity-positiveInfinity)
+	// Output:
+	// NaN == NaN: false
+	// is NaN: true
+	// inf - inf: NaN
+
+	// rounding
+	trace()
+	fmt.Printf("floor: %v, ceil: %v\n", math.Floor(-2.5), math.Ceil(-2.5))
+	fmt.Printf("round: %v, trunc: %v\n", math.Round(-2.5), math.Trunc(-2.5))
+	// Output:
+	// floor: -3, ceil: -2
+	// round: -3, trunc: -2
+
+	// converting to an integer truncates toward zero
+	// a constant with a fraction does not even compile
+	trace()
+	price := -2.7
+	fmt.Printf("int(-2.7): %v\n", int(price))
+	// Output:
+	// int(-2.7): -2
+
+	// modulo and absolute value of floats
+	// % only works on integers
+	trace()
+	fmt.Printf("mod: %v, abs: %v\n", math.Mod(7.5, 2), math.Abs(-3.2))
+	fmt.Printf("sqrt: %v, pow: %v\n", math.Sqrt(2), math.Pow(2, 10))
+	// Output:
+	// mod: 1.5, abs: 3.2
+	// sqrt: 1.4142135623730951, pow: 1024
+}
+
+func constantsLesson() {
+
+	// untyped constants have a kind but no type yet
+	// they take the type the context needs
+	trace()
+	const ratio = 2
+	var small int8 = ratio
+	var precise float64 = ratio
+	var span time.Duration = ratio * time.Second
+	fmt.Printf("%T %v, %T %v, %T %v\n", small, small, precise, precise, span, span)
+	// Output:
+	// int8 2, float64 2, time.Duration 2s
+
+	// a typed constant keeps its type
+	// const typed int = 2
+	// var span time.Duration = typed * time.Second
+	// does not compile, int and time.Duration mismatch
+	trace()
+	const typed int = 2
+	fmt.Printf("typed: %T\n", typed)
+	// Output:
+	// typed: int
+
+	// without a context they get their default type
+	// int, float64, rune, string, bool or complex128
+	// rune is printed as int32 since it is an alias
+	trace()
+	integer, float, character, text := 1, 1.5, 'g', "go"
+	fmt.Printf("%T %T %T %T\n", integer, float, character, text)
+	// Output:
+	// int float64 int32 string
+
+	// constant expressions are computed exactly
+	// with at least 256 bits of precision
+	// 1 << 62 fits an int64 on every platform
+	// 1 << 100 only exists while compiling
+	trace()
+	const big = 1 << 62
+	const huge = 1 << 100
+	var fits int64 = big
+	fmt.Printf("big: %v, huge >> 90: %v\n", fits, huge>>90)
+	// Output:
+	// big: 4611686018427387904, huge >> 90: 1024
+
+	// divisions of untyped integers stay integers
+	// an untyped float anywhere makes it exact
+	trace()
+	const third = 1 / 3
+	const floatThird = 1 / 3.0
+	fmt.Printf("1 / 3: %v, 1 / 3.0: %.4f\n", third, floatThird)
+	// Output:
+	// 1 / 3: 0, 1 / 3.0: 0.3333
+
+	// overflowing a type is a compile error
+	// var tooBig int8 = 200
+	// var alsoTooBig uint = -1
+	// both fail with constant overflows
+	// the same values computed at run time wrap around
+	trace()
+	limit := 200
+	fmt.Printf("int8(200) at run time: %v\n", int8(limit))
+	// Output:
+	// int8(200) at run time: -56
+}
@@ -0,0 +1,38 @@
+package main
+
+import "testing"
+
+func TestPositiveModulo(t *testing.T) {
+	tests := []struct {
+		a, n, want int
+	}{
+		{7, 3, 1},
+		{-7, 3, 2},
+		{-3, 3, 0},
+		{0, 5, 0},
+		{-1, 5, 4},
+	}
+	for _, test := range tests {
+		if got := positiveModulo(test.a, test.n); got != test.want {
+			t.Errorf("positiveModulo(%v, %v) = %v, want %v", test.a, test.n, got, test.want)
+		}
+	}
+}
+
+func TestAlmostEqual(t *testing.T) {
+	tests := []struct {
+		a, b float64
+		want bool
+	}{
+		{0.1 + 0.2, 0.3, true},
+		{1e20, 1e20 + 1, true},
+		{1.0, 1.1, false},
+		{0, 0, true},
+		{0, 1e-20, false},
+	}
+	for _, test := range tests {
+		if got := almostEqual(test.a, test.b, 1e-9); got != test.want {
+			t.Errorf("almostEqual(%v, %v) = %v, want %v", test.a, test.b, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+// extra lessons contributed outside the main package
+// a topic pack provides its lessons
+// and registers itself from an init function
+//
+//	func init() {
+//		pack.Register(dataEngineering{})
+//	}
+//
+// it is then linked in with a blank import
+// from a file of its own
+//
+//	import _ "github.com/someone/dataengineering"
+//
+// or built as a plugin exporting a Provider variable
+// go build -buildmode=plugin -o dataengineering.so
+// go run . -plugins dataengineering.so
+package pack
+
+// a lesson demonstrates a single topic
+// keywords help finding it
+// it builds on the lessons it requires
+// tags give its difficulty and its topics
+// the fields are the ones of the lessons of the repo
+// which cannot be imported from a main package
+type Lesson struct {
+	Name        string
+	Description string
+	Run         func()
+	Keywords    []string
+	Requires    []string
+	Tags        []string
+}
+
+type LessonProvider interface {
+	Lessons() []Lesson
+}
+
+// a provider can also name
+// the chapter of its lessons
+type Titled interface {
+	Title() string
+}
+
+var providers []LessonProvider
+
+func Register(provider LessonProvider) {
+	providers = append(providers, provider)
+}
+
+func Providers() []LessonProvider {
+	registered := make([]LessonProvider, len(providers))
+	copy(registered, providers)
+	return registered
+}
@@ -0,0 +1,23 @@
+package pack
+
+import "testing"
+
+type testProvider []Lesson
+
+func (p testProvider) Lessons() []Lesson {
+	return p
+}
+
+func TestRegister(t *testing.T) {
+	providersReal := providers
+	defer func() { providers = providersReal }()
+	providers = nil
+
+	Register(testProvider{{Name: "first"}})
+	Register(testProvider{{Name: "second"}, {Name: "third"}})
+
+	registered := Providers()
+	if len(registered) != 2 || len(registered[0].Lessons()) != 1 || len(registered[1].Lessons()) != 2 {
+		t.Errorf("Providers() = %v, want the two registered providers", registered)
+	}
+}
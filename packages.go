@@ -0,0 +1,175 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+
+	"github.com/Mathieu-Desrochers/Learning-Go/initorder"
+	"github.com/Mathieu-Desrochers/Learning-Go/shapes"
+)
+
+func init() {
+	RegisterChapter(Chapter{10, "Packages", []Lesson{
+		{"initialization", "package variables and init functions", initializationLesson, []string{"init", "initialization order", "package variable", "import"}, []string{"variables", "functions"}, []string{"intermediate", "packages"}},
+		{"visibility", "exported and unexported names across packages", visibilityLesson, []string{"exported", "unexported", "package", "import", "constructor", "shapes"}, []string{"encapsulation", "interfaces"}, []string{"beginner", "packages"}},
+		{"embed", "embedding files with go:embed", embedLesson, []string{"embed", "go:embed", "embed.FS", "fs.Sub", "http.FileServerFS", "assets"}, []string{"initialization"}, []string{"intermediate", "packages"}},
+		{"build-tags", "build constraints and tags", buildTagsLesson, []string{"go:build", "build tag", "build constraint", "GOOS", "cgo", "-tags"}, []string{"initialization"}, []string{"intermediate", "packages"}},
+		{"generate", "go generate and stringer", generateLesson, []string{"go generate", "go:generate", "stringer", "code generation", "String"}, []string{"types", "stringers"}, []string{"intermediate", "packages"}},
+	}})
+}
+
+// the main package initializes last
+// after every package it imports
+var _ = initorder.Record("main: package variable")
+
+func init() {
+	initorder.Record("main: init")
+}
+
+func initializationLesson() {
+
+	// the imported package initialized first
+	// its variables in dependency order
+	// then its init functions file by file
+	// and main came last
+	trace()
+	for i, event := range initorder.Events() {
+		fmt.Printf("%v. %v\n", i+1, event)
+	}
+	// Output:
+	// 1. b.go: name
+	// 2. a.go: greeting, after the name it needs
+	// 3. b.go: farewell
+	// 4. a.go: init
+	// 5. b.go: first init
+	// 6. b.go: second init
+	// 7. main: package variable
+	// 8. main: init
+}
+
+func visibilityLesson() {
+
+	// exported names are used
+	// through the name of their package
+	trace()
+	circle := shapes.Circle{Radius: 2}
+	fmt.Printf("circle area: %v, perimeter: %v\n", circle.Area(), circle.Perimeter())
+	// Output:
+	// circle area: 12.57, perimeter: 12.57
+
+	// unexported fields are set by the constructor
+	// and read through exported methods
+	trace()
+	rectangle, _ := shapes.NewRectangle(3, 4)
+	fmt.Printf("rectangle %vx%v, area: %v\n", rectangle.Width(), rectangle.Height(), rectangle.Area())
+	// Output:
+	// rectangle 3x4, area: 12
+
+	// the constructor is the only way in
+	// so it can refuse bad values
+	trace()
+	_, err := shapes.NewRectangle(-1, 4)
+	fmt.Printf("negative side: %v, not positive: %v\n", err, errors.Is(err, shapes.ErrNotPositive))
+	// Output:
+	// negative side: sides must be positive, not positive: true
+
+	// none of these compile
+	// rectangle.width
+	//   rectangle.width undefined (type *shapes.Rectangle has no field or method width, but does have method Width)
+	// shapes.Rectangle{width: 2}
+	//   cannot refer to unexported field width in struct literal of type shapes.Rectangle
+	// shapes.round(1.5)
+	//   name round not exported by package shapes
+	// the exported methods rounding for us
+	// are the only way to reach round
+	trace()
+	fmt.Printf("rounded area: %v\n", shapes.Circle{Radius: 1}.Area())
+	// Output:
+	// rounded area: 3.14
+
+	// the zero value is still available
+	// so unexported fields must make sense at zero
+	trace()
+	var empty shapes.Rectangle
+	fmt.Printf("zero rectangle area: %v\n", empty.Area())
+	// Output:
+	// zero rectangle area: 0
+
+	// any package's types can satisfy our interfaces
+	// and the other way around
+	trace()
+	for _, shape := range []shapes.Shape{circle, rectangle} {
+		fmt.Printf("%T perimeter: %v\n", shape, shape.Perimeter())
+	}
+	// Output:
+	// shapes.Circle perimeter: 12.57
+	// *shapes.Rectangle perimeter: 14
+}
+
+// the file whose constraints match an operating system
+// mirroring the //go:build lines of the platform files
+func platformFileFor(goos string) string {
+	switch goos {
+	case "linux", "windows":
+		return "platform_" + goos + ".go"
+	}
+	return "platform_other.go"
+}
+
+func buildTagsLesson() {
+
+	// a //go:build line at the top of a file
+	// decides whether it is compiled
+	// the three platform files define the same constant
+	// only the one matching the target is built
+	// GOOS=windows go build
+	trace()
+	fmt.Printf("compiled the file of this platform: %v\n", platformFile == platformFileFor(runtime.GOOS))
+	detail("compiled %v for %v\n", platformFile, runtime.GOOS)
+	// Output:
+	// compiled the file of this platform: true
+
+	// custom tags are off unless given with -tags
+	// go run -tags demo . -lesson build-tags
+	trace()
+	fmt.Printf("edition: %v\n", edition)
+	// Output:
+	// edition: full
+
+	// constraints combine with && || and !
+	// main_ffi.go needs cgo
+	// main_noffi.go stands in when it is disabled
+	// both define cgoEnabled so the code using it builds either way
+	// CGO_ENABLED=0 go run . -lesson cgo
+	trace()
+	fmt.Printf("cgoEnabled is a %T\n", cgoEnabled)
+	detail("cgo: %v\n", cgoEnabled)
+	// Output:
+	// cgoEnabled is a bool
+}
+
+func generateLesson() {
+
+	// go generate runs the commands
+	// of the //go:generate comments
+	// stringer wrote flavor_string.go
+	// which is checked in like any other source
+	trace()
+	fmt.Println(Chocolate)
+	fmt.Printf("%v is %d\n", Pistachios, Pistachios)
+	fmt.Println(Flavor(7))
+	// Output:
+	// Chocolate
+	// Pistachios is 2
+	// Flavor(7)
+
+	// the generated code packs the names into one string
+	// and slices it with a table of offsets
+	// its array index checks stop the build
+	// when a flavor is added without generating again
+	trace()
+	fmt.Printf("%q %v\n", _Flavor_name, _Flavor_index)
+	// Output:
+	// "VanillaChocolatePistachios" [0 7 16 26]
+}
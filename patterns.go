@@ -0,0 +1,173 @@
+package main
+
+import (
+	"fmt"
+	"time"
+)
+
+// a server with sensible defaults
+// that callers may override
+type Server struct {
+	name    string
+	port    int
+	timeout time.Duration
+}
+
+// an option is a function
+// that changes the server being built
+type Option func(*Server)
+
+func WithName(name string) Option {
+	return func(s *Server) {
+		s.name = name
+	}
+}
+
+func WithPort(port int) Option {
+	return func(s *Server) {
+		s.port = port
+	}
+}
+
+func WithTimeout(timeout time.Duration) Option {
+	return func(s *Server) {
+		s.timeout = timeout
+	}
+}
+
+// the defaults are set first
+// then each option in order
+// new options never break existing callers
+func NewServer(options ...Option) *Server {
+	server := &Server{name: "server", port: 8080, timeout: 30 * time.Second}
+	for _, option := range options {
+		option(server)
+	}
+	return server
+}
+
+func (s *Server) String() string {
+	return fmt.Sprintf("%v on port %v with a %v timeout", s.name, s.port, s.timeout)
+}
+
+// the alternative takes a config struct
+// zero values must stand for the defaults
+// so a port of 0 cannot be asked for
+type ServerConfig struct {
+	Name    string
+	Port    int
+	Timeout time.Duration
+}
+
+func NewServerFromConfig(config ServerConfig) *Server {
+	server := &Server{name: "server", port: 8080, timeout: 30 * time.Second}
+	if config.Name != "" {
+		server.name = config.Name
+	}
+	if config.Port != 0 {
+		server.port = config.Port
+	}
+	if config.Timeout != 0 {
+		server.timeout = config.Timeout
+	}
+	return server
+}
+
+func optionsLesson() {
+
+	// no options gives the defaults
+	trace()
+	fmt.Println(NewServer())
+	// Output:
+	// server on port 8080 with a 30s timeout
+
+	// options read like named arguments
+	// and can be given in any order
+	trace()
+	fmt.Println(NewServer(WithTimeout(5*time.Second), WithName("api")))
+	// Output:
+	// api on port 8080 with a 5s timeout
+
+	// options are values
+	// they can be collected and reused
+	trace()
+	testOptions := []Option{WithName("test"), WithPort(0)}
+	fmt.Println(NewServer(testOptions...))
+	// Output:
+	// test on port 0 with a 30s timeout
+
+	// a config struct is simpler
+	// but cannot tell a zero value from a missing one
+	trace()
+	fmt.Println(NewServerFromConfig(ServerConfig{Name: "api", Timeout: 5 * time.Second}))
+	fmt.Println(NewServerFromConfig(ServerConfig{Name: "test", Port: 0}))
+	// Output:
+	// api on port 8080 with a 5s timeout
+	// test on port 8080 with a 30s timeout
+}
+
+// a fluent builder returns itself from every setter
+// so the calls can be chained
+type CookieBuilder struct {
+	cookie Cookie
+}
+
+func NewCookieBuilder() *CookieBuilder {
+	return &CookieBuilder{Cookie{Size: 10, Flavour: "Vanilla", Rating: 3}}
+}
+
+func (b *CookieBuilder) Size(size int) *CookieBuilder {
+	b.cookie.Size = size
+	return b
+}
+
+func (b *CookieBuilder) Flavour(flavour string) *CookieBuilder {
+	b.cookie.Flavour = flavour
+	return b
+}
+
+func (b *CookieBuilder) Rating(rating int) *CookieBuilder {
+	b.cookie.Rating = rating
+	return b
+}
+
+// the setters cannot return errors
+// without breaking the chain
+// so validation waits for Build
+func (b *CookieBuilder) Build() (Cookie, error) {
+	if err := validateCookie(&b.cookie); err != nil {
+		return Cookie{}, err
+	}
+	return b.cookie, nil
+}
+
+func buildersLesson() {
+
+	// chaining the setters
+	trace()
+	built, err := NewCookieBuilder().Size(12).Flavour("Chocolate").Rating(5).Build()
+	fmt.Printf("built: %+v, err: %v\n", built, err)
+	// Output:
+	// built: {Size:12 Flavour:Chocolate Rating:5}, err: <nil>
+
+	// a struct literal gives the same value
+	// named fields already read like the setters
+	// and unset fields get their zero value
+	trace()
+	literal := Cookie{Size: 12, Flavour: "Chocolate", Rating: 5}
+	fmt.Printf("literal: %+v, equal: %v\n", literal, built == literal)
+	// Output:
+	// literal: {Size:12 Flavour:Chocolate Rating:5}, equal: true
+
+	// a builder earns its place
+	// with defaults other than zero values
+	// or validation of the finished value
+	trace()
+	_, err = NewCookieBuilder().Rating(9).Build()
+	fmt.Println(err)
+	defaulted, _ := NewCookieBuilder().Flavour("Almonds").Build()
+	fmt.Printf("defaulted: %+v\n", defaulted)
+	// Output:
+	// invalid rating 9: must be between 1 and 5
+	// defaulted: {Size:10 Flavour:Almonds Rating:3}
+}
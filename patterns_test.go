@@ -0,0 +1,43 @@
+package main
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestNewServerOptions(t *testing.T) {
+	tests := []struct {
+		options []Option
+		want    Server
+	}{
+		{nil, Server{"server", 8080, 30 * time.Second}},
+		{[]Option{WithName("api"), WithTimeout(time.Second)}, Server{"api", 8080, time.Second}},
+		{[]Option{WithPort(0)}, Server{"server", 0, 30 * time.Second}},
+		{[]Option{WithPort(1), WithPort(2)}, Server{"server", 2, 30 * time.Second}},
+	}
+	for _, test := range tests {
+		if got := NewServer(test.options...); *got != test.want {
+			t.Errorf("NewServer(%v options) = %v, want %v", len(test.options), got, &test.want)
+		}
+	}
+}
+
+func TestNewServerFromConfigDefaults(t *testing.T) {
+	got := NewServerFromConfig(ServerConfig{Port: 9090})
+	if want := (Server{"server", 9090, 30 * time.Second}); *got != want {
+		t.Errorf("NewServerFromConfig = %v, want %v", got, &want)
+	}
+}
+
+func TestCookieBuilder(t *testing.T) {
+	got, err := NewCookieBuilder().Size(12).Flavour("Chocolate").Rating(5).Build()
+	if want := (Cookie{12, "Chocolate", 5}); got != want || err != nil {
+		t.Errorf("Build() = %+v, %v, want %+v, nil", got, err, want)
+	}
+
+	var validationError *ValidationError
+	if _, err := NewCookieBuilder().Size(0).Build(); !errors.As(err, &validationError) {
+		t.Errorf("Build() with a size of 0 = %v, want a ValidationError", err)
+	}
+}
@@ -0,0 +1,8 @@
+//go:build linux
+
+package main
+
+// the _linux suffix of the file name
+// already implies the constraint
+// the line above makes it explicit
+const platformFile = "platform_linux.go"
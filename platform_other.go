@@ -0,0 +1,8 @@
+//go:build !linux && !windows
+
+package main
+
+// every other platform
+// the constraints of the three files never overlap
+// so exactly one of them defines the constant
+const platformFile = "platform_other.go"
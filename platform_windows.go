@@ -0,0 +1,5 @@
+//go:build windows
+
+package main
+
+const platformFile = "platform_windows.go"
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"plugin"
+	"strings"
+
+	"github.com/Mathieu-Desrochers/Learning-Go/pack"
+)
+
+// loading the lessons of topic packs
+// registered with the pack package
+// or built as plugins
+// go run . -plugins dataengineering.so
+// their chapters come after the ones of the repo
+const firstPackChapter = 100
+
+func loadPacks(providers []pack.LessonProvider, pluginPaths []string) error {
+	titles := make([]string, len(providers))
+	for _, path := range pluginPaths {
+		provider, err := openPlugin(path)
+		if err != nil {
+			return err
+		}
+		providers = append(providers, provider)
+		titles = append(titles, strings.TrimSuffix(filepath.Base(path), filepath.Ext(path)))
+	}
+
+	for i, provider := range providers {
+		title := titles[i]
+		if titled, ok := provider.(pack.Titled); ok {
+			title = titled.Title()
+		}
+		if title == "" {
+			title = "Community"
+		}
+		// RegisterChapter panics on a name taken twice
+		// a pack is checked first to report an error instead
+		var lessons []Lesson
+		names := map[string]bool{}
+		for _, lesson := range provider.Lessons() {
+			if _, exists := Find(lesson.Name); exists || names[lesson.Name] {
+				return fmt.Errorf("while loading pack %v: lesson %v already exists", title, lesson.Name)
+			}
+			names[lesson.Name] = true
+			lessons = append(lessons, Lesson(lesson))
+		}
+		RegisterChapter(Chapter{firstPackChapter + i, title, lessons})
+	}
+	return nil
+}
+
+// a plugin exports its provider
+// as a variable named Provider
+func openPlugin(path string) (pack.LessonProvider, error) {
+	opened, err := plugin.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("while opening plugin %v: %w", path, err)
+	}
+	symbol, err := opened.Lookup("Provider")
+	if err != nil {
+		return nil, fmt.Errorf("while loading plugin %v: %w", path, err)
+	}
+
+	// looking up a variable
+	// gives a pointer to it
+	switch provider := symbol.(type) {
+	case *pack.LessonProvider:
+		return *provider, nil
+	case pack.LessonProvider:
+		return provider, nil
+	}
+	return nil, fmt.Errorf("plugin %v Provider is a %T, not a pack.LessonProvider", path, symbol)
+}
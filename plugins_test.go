@@ -0,0 +1,59 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Mathieu-Desrochers/Learning-Go/pack"
+)
+
+type dataEngineering struct{}
+
+func (dataEngineering) Title() string {
+	return "Data engineering"
+}
+
+func (dataEngineering) Lessons() []pack.Lesson {
+	return []pack.Lesson{{Name: "csv", Description: "reading csv files", Run: func() {}}}
+}
+
+// a pack reusing the name of a lesson
+// of the repo or of its own
+type duplicatePack struct {
+	names []string
+}
+
+func (p duplicatePack) Lessons() []pack.Lesson {
+	var lessons []pack.Lesson
+	for _, name := range p.names {
+		lessons = append(lessons, pack.Lesson{Name: name, Run: func() {}})
+	}
+	return lessons
+}
+
+func TestLoadPacks(t *testing.T) {
+	registeredChaptersReal := registeredChapters
+	defer func() { registeredChapters = registeredChaptersReal }()
+	if err := loadPacks([]pack.LessonProvider{dataEngineering{}}, nil); err != nil {
+		t.Fatal(err)
+	}
+	chapters := Chapters()
+	last := chapters[len(chapters)-1]
+	if last.Title != "Data engineering" || last.Order < firstPackChapter {
+		t.Errorf("last chapter = %v %v, want Data engineering", last.Order, last.Title)
+	}
+	if _, ok := Find("csv"); !ok {
+		t.Error("Find(csv) did not find the lesson of the pack")
+	}
+
+	if err := loadPacks(nil, []string{"missing.so"}); err == nil {
+		t.Error("loadPacks(missing.so) did not fail")
+	}
+
+	for _, names := range [][]string{{"slices"}, {"csv"}, {"json", "json"}} {
+		err := loadPacks([]pack.LessonProvider{duplicatePack{names}}, nil)
+		if err == nil || !strings.Contains(err.Error(), "lesson "+names[0]+" already exists") {
+			t.Errorf("loadPacks(%v) = %v, want lesson %v already exists", names, err, names[0])
+		}
+	}
+}
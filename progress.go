@@ -0,0 +1,92 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// the lessons completed so far
+// saved as json under the user config directory
+type progress struct {
+	path      string
+	Completed map[string]bool `json:"completed"`
+}
+
+func progressPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "learning-go", "progress.json"), nil
+}
+
+// a progress without a path is kept in memory only
+// when the user config directory cannot be located
+func newProgress(path string) *progress {
+	return &progress{path: path, Completed: make(map[string]bool)}
+}
+
+// a missing file means
+// nothing was completed yet
+func (p *progress) Load() error {
+	if p.path == "" {
+		return nil
+	}
+	content, err := os.ReadFile(p.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(content, p); err != nil {
+		return err
+	}
+
+	// a file holding "completed": null
+	// leaves a nil map that MarkDone cannot write to
+	if p.Completed == nil {
+		p.Completed = make(map[string]bool)
+	}
+	return nil
+}
+
+func (p *progress) Save() error {
+	if p.path == "" {
+		return nil
+	}
+	content, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(p.path), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(p.path, content, 0644)
+}
+
+func (p *progress) MarkDone(name string) {
+	p.Completed[name] = true
+}
+
+func (p *progress) Done(name string) bool {
+	return p.Completed[name]
+}
+
+// completed lessons that were since
+// removed do not count
+func (p *progress) Percent(lessons []Lesson) int {
+	if len(lessons) == 0 {
+		return 0
+	}
+	done := 0
+	for _, lesson := range lessons {
+		if p.Done(lesson.Name) {
+			done++
+		}
+	}
+	return done * 100 / len(lessons)
+}
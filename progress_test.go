@@ -0,0 +1,73 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestProgressSaveAndLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "learning-go", "progress.json")
+
+	saved := newProgress(path)
+	if err := saved.Load(); err != nil {
+		t.Fatalf("loading a missing file failed: %v", err)
+	}
+	saved.MarkDone("slices")
+	saved.MarkDone("maps")
+	if err := saved.Save(); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	loaded := newProgress(path)
+	if err := loaded.Load(); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if !loaded.Done("slices") || !loaded.Done("maps") || loaded.Done("arrays") {
+		t.Errorf("loaded completed lessons %v, want slices and maps", loaded.Completed)
+	}
+}
+
+func TestProgressLoadNullCompleted(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "progress.json")
+	if err := os.WriteFile(path, []byte(`{"completed": null}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded := newProgress(path)
+	if err := loaded.Load(); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	loaded.MarkDone("slices")
+	if !loaded.Done("slices") {
+		t.Errorf("loaded completed lessons %v, want slices", loaded.Completed)
+	}
+}
+
+func TestProgressWithoutPath(t *testing.T) {
+	progress := newProgress("")
+	if err := progress.Load(); err != nil {
+		t.Errorf("Load without a path failed: %v", err)
+	}
+	progress.MarkDone("slices")
+	if err := progress.Save(); err != nil {
+		t.Errorf("Save without a path failed: %v", err)
+	}
+	if !progress.Done("slices") {
+		t.Errorf("completed lessons %v, want slices", progress.Completed)
+	}
+}
+
+func TestProgressPercent(t *testing.T) {
+	lessons := []Lesson{{Name: "arrays"}, {Name: "slices"}, {Name: "maps"}, {Name: "strings"}}
+	progress := newProgress("")
+	progress.MarkDone("slices")
+	progress.MarkDone("removed")
+
+	if got := progress.Percent(lessons); got != 25 {
+		t.Errorf("Percent() = %v, want 25", got)
+	}
+	if got := progress.Percent(nil); got != 0 {
+		t.Errorf("Percent(nil) = %v, want 0", got)
+	}
+}
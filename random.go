@@ -0,0 +1,17 @@
+package main
+
+import "math/rand/v2"
+
+// picking a lesson for a daily drill
+// go run . -random
+// optionally among the ones with some tags
+// go run . -random -tags concurrency
+// the same seed picks the same lesson
+// go run . -random -seed 42
+func pickRandom(lessons []Lesson, seed uint64) (Lesson, bool) {
+	if len(lessons) == 0 {
+		return Lesson{}, false
+	}
+	random := rand.New(rand.NewPCG(seed, seed))
+	return lessons[random.IntN(len(lessons))], true
+}
@@ -0,0 +1,20 @@
+package main
+
+import "testing"
+
+func TestPickRandom(t *testing.T) {
+	lessons := []Lesson{{Name: "a"}, {Name: "b"}, {Name: "c"}}
+
+	// the same seed picks the same lesson
+	for seed := uint64(0); seed < 10; seed++ {
+		first, _ := pickRandom(lessons, seed)
+		second, _ := pickRandom(lessons, seed)
+		if first.Name != second.Name {
+			t.Errorf("pickRandom(seed %v) = %v then %v, want the same lesson", seed, first.Name, second.Name)
+		}
+	}
+
+	if lesson, ok := pickRandom(nil, 0); ok {
+		t.Errorf("pickRandom(nil) = %v, want none", lesson.Name)
+	}
+}
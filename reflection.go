@@ -0,0 +1,139 @@
+package main
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// adding up the sizes of the cookies
+// the compiler knows where the field is
+func totalSizeDirect(cookies []Cookie) int {
+	total := 0
+	for _, cookie := range cookies {
+		total += cookie.Size
+	}
+	return total
+}
+
+// reflection finds the field by name on every call
+// and boxes every value into an interface
+func totalSizeReflect(cookies []Cookie) int {
+	total := 0
+	for _, cookie := range cookies {
+		total += int(reflect.ValueOf(cookie).FieldByName("Size").Int())
+	}
+	return total
+}
+
+func reflectionLesson() {
+
+	// using reflection
+	trace()
+	reflection := func(somethingA, somethingB interface{}) {
+
+		// getting something's type
+		typeA := reflect.TypeOf(somethingA).Elem()
+		fmt.Printf("somethingA is a %v\n", typeA.Kind())
+
+		typeB := reflect.TypeOf(somethingB).Elem()
+		fmt.Printf("somethingB is a %v\n", typeB)
+
+		// getting something's value
+		valueA := reflect.ValueOf(somethingA).Elem().Int()
+		fmt.Printf("somethingA is %v\n", valueA)
+
+		valueB := reflect.ValueOf(somethingB).Elem()
+		for i := 0; i < valueB.NumField(); i++ {
+			fmt.Printf("somethingB.%v is %v\n", valueB.Type().Field(i).Name, valueB.Field(i))
+		}
+
+		// setting something's value
+		reflect.ValueOf(somethingA).Elem().Set(reflect.ValueOf(2))
+		reflect.ValueOf(somethingB).Elem().FieldByName("X").Set(reflect.ValueOf(10))
+
+		// accessing field tags
+		tag := reflect.ValueOf(somethingB).Elem().Type().Field(0).Tag.Get("color")
+		fmt.Printf("somethingB.X has color %v\n", tag)
+	}
+
+	number := 1
+	structure := struct {
+		X int `color:"red"`
+		Y int `color:"blue"`
+	}{1, 2}
+
+	// setting values must be done through a pointer
+	// always use them for consistency
+	trace()
+	reflection(&number, &structure)
+
+	fmt.Printf("number is now %v\n", number)
+	fmt.Printf("structure is now %v\n", structure)
+	// Output:
+	// somethingA is a int
+	// somethingB is a struct { X int "color:\"red\""; Y int "color:\"blue\"" }
+	// somethingA is 1
+	// somethingB.X is 1
+	// somethingB.Y is 2
+	// somethingB.X has color red
+	// number is now 2
+	// structure is now {10 2}
+
+	// iterating over the fields of a struct
+	// the type gives the names and the tags
+	// the value gives the contents
+	trace()
+	cookie := Cookie{10, "Chocolate", 5}
+	cookieValue := reflect.ValueOf(cookie)
+	for i := 0; i < cookieValue.NumField(); i++ {
+		field := cookieValue.Type().Field(i)
+		fmt.Printf("%v %v = %v\n", field.Name, field.Type, cookieValue.Field(i))
+	}
+	// Output:
+	// Size int = 10
+	// Flavour string = Chocolate
+	// Rating int = 5
+
+	// a value read from a copy cannot be set
+	// CanSet tells which ones can
+	trace()
+	fmt.Printf("copy can set: %v\n", reflect.ValueOf(cookie).Field(0).CanSet())
+	fmt.Printf("pointer can set: %v\n", reflect.ValueOf(&cookie).Elem().Field(0).CanSet())
+	reflect.ValueOf(&cookie).Elem().FieldByName("Rating").SetInt(3)
+	fmt.Printf("cookie is now %+v\n", cookie)
+	// Output:
+	// copy can set: false
+	// pointer can set: true
+	// cookie is now {Size:10 Flavour:Chocolate Rating:3}
+
+	// calling methods by name
+	// the method set depends on pointer or value
+	trace()
+	animal := &Animal{4}
+	animalValue := reflect.ValueOf(animal)
+	fmt.Printf("methods: %v\n", animalValue.NumMethod())
+	animalValue.MethodByName("GrowLeg").Call(nil)
+	results := animalValue.MethodByName("CountLegs").Call(nil)
+	fmt.Printf("legs: %v\n", results[0].Int())
+	// Output:
+	// methods: 4
+	// legs: 5
+
+	// reflection is checked at run time
+	// a wrong name gives an invalid value
+	// calling it panics
+	trace()
+	missing := animalValue.MethodByName("Fly")
+	fmt.Printf("has Fly: %v\n", missing.IsValid())
+	// Output:
+	// has Fly: false
+
+	// reflection is much slower than direct access
+	// keep it for code that cannot know the types
+	// go test -bench TotalSize
+	trace()
+	cookies := []Cookie{{10, "Chocolate", 5}, {12, "Peanuts", 4}}
+	fmt.Printf("direct: %v, reflect: %v\n", totalSizeDirect(cookies), totalSizeReflect(cookies))
+	// Output:
+	// direct: 22, reflect: 22
+}
@@ -0,0 +1,28 @@
+package main
+
+import "testing"
+
+var totalSizeCookies = []Cookie{{10, "Chocolate", 5}, {12, "Peanuts", 4}, {8, "Almonds", 3}}
+
+func TestTotalSize(t *testing.T) {
+	if direct, reflected := totalSizeDirect(totalSizeCookies), totalSizeReflect(totalSizeCookies); direct != 30 || reflected != 30 {
+		t.Errorf("totalSizeDirect = %v, totalSizeReflect = %v, want 30", direct, reflected)
+	}
+}
+
+// reflection allocates and looks up
+// the field by name on every cookie
+// go test -bench TotalSize
+func BenchmarkTotalSizeDirect(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		totalSizeDirect(totalSizeCookies)
+	}
+}
+
+func BenchmarkTotalSizeReflect(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		totalSizeReflect(totalSizeCookies)
+	}
+}
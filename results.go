@@ -0,0 +1,68 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"time"
+)
+
+// reporting the lessons as json
+// for grading scripts and dashboards
+// go run . -json
+type lessonResult struct {
+	Lesson     string  `json:"lesson"`
+	DurationMs float64 `json:"durationMs"`
+	Output     string  `json:"output"`
+	Error      string  `json:"error"`
+}
+
+// a lesson that timed out is abandoned
+// it keeps running and printing to os.Stdout
+// after its output was captured
+// the caller reports the lessons after it as not run
+// so its prints cannot land in the output of the next one
+func runForResult(lesson Lesson, timeout time.Duration) (lessonResult, bool) {
+	var timing lessonTiming
+	output, err := captureOutput(func() {
+		timing = timeLesson(lesson, timeout)
+	})
+
+	result := lessonResult{
+		Lesson:     lesson.Name,
+		DurationMs: float64(timing.Duration) / float64(time.Millisecond),
+		Output:     output,
+	}
+	if timing.Err != nil {
+		result.Error = timing.Err.Error()
+	} else if err != nil {
+		result.Error = err.Error()
+	}
+	return result, errors.Is(timing.Err, errTimedOut)
+}
+
+// with -json only the array goes to the real stdout
+// os.Stdout points at os.DevNull outside the captures
+// where the prints of an abandoned lesson end up
+// the text mode discards them the same way once a lesson timed out
+// returns the real stdout to print the array to
+func discardStdout() (*os.File, error) {
+	discard, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+	if err != nil {
+		return nil, err
+	}
+	stdout := os.Stdout
+	os.Stdout = discard
+	return stdout, nil
+}
+
+func printResults(w io.Writer, results []lessonResult) error {
+	// an empty run is still an array
+	if results == nil {
+		results = []lessonResult{}
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(results)
+}
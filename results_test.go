@@ -0,0 +1,155 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRunForResult(t *testing.T) {
+	lesson, _ := Find("variables")
+	result, _ := runForResult(lesson, time.Second)
+	if result.Lesson != "variables" || result.Output != "7\n" || result.Error != "" || result.DurationMs <= 0 {
+		t.Errorf("runForResult(variables) = %+v, want variables printing 7", result)
+	}
+
+	result, abandoned := runForResult(Lesson{Name: "broken", Run: func() { panic("ooops") }}, time.Second)
+	if result.Error != "panicked: ooops" || abandoned {
+		t.Errorf("runForResult(broken) = %q, %v, want panicked: ooops", result.Error, abandoned)
+	}
+}
+
+func TestRunForResultDiscardsAbandonedOutput(t *testing.T) {
+	stdout := os.Stdout
+	defer func() { os.Stdout = stdout }()
+	real, err := os.CreateTemp(t.TempDir(), "stdout")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer real.Close()
+	os.Stdout = real
+	if got, err := discardStdout(); got != real || err != nil {
+		t.Fatalf("discardStdout() = %v, %v, want the real stdout", got, err)
+	}
+
+	release := make(chan bool)
+	printed := make(chan bool)
+	hanging := Lesson{Name: "hanging", Run: func() {
+		<-release
+		fmt.Println("printed after the timeout")
+		close(printed)
+	}}
+	result, abandoned := runForResult(hanging, 10*time.Millisecond)
+	if result.Error != "timed out after 10ms" || !abandoned {
+		t.Fatalf("runForResult(hanging) = %q, %v, want timed out and abandoned", result.Error, abandoned)
+	}
+	close(release)
+	<-printed
+
+	if info, err := real.Stat(); err != nil || info.Size() != 0 {
+		t.Errorf("the abandoned lesson printed to the real stdout")
+	}
+}
+
+// the test binary runs main in a child process
+// so the output of C code and abandoned lessons
+// reaches the same stdout as with go run .
+// returns what it printed to stdout and stderr
+func runMain(t *testing.T, args string) (string, string, error) {
+	config := t.TempDir()
+	command := exec.Command(os.Args[0], "-test.run=^TestJSONOutput$")
+	command.Env = append(os.Environ(),
+		"LEARNING_GO_MAIN_ARGS="+args,
+		"HOME="+config, "XDG_CONFIG_HOME="+config, "AppData="+config)
+	var stdout, stderr strings.Builder
+	command.Stdout = &stdout
+	command.Stderr = &stderr
+	err := command.Run()
+	return stdout.String(), stderr.String(), err
+}
+
+func TestJSONOutput(t *testing.T) {
+	if args, ok := os.LookupEnv("LEARNING_GO_MAIN_ARGS"); ok {
+		os.Args = append([]string{os.Args[0]}, strings.Fields(args)...)
+		main()
+		os.Exit(0)
+	}
+
+	stdout, stderr, err := runMain(t, "-json")
+	if err != nil {
+		t.Fatalf("go run . -json failed: %v\n%v", err, stderr)
+	}
+	var results []lessonResult
+	if err := json.Unmarshal([]byte(stdout), &results); err != nil {
+		t.Fatalf("go run . -json is not json: %v\n%v", err, stdout)
+	}
+	if len(results) != len(List()) {
+		t.Errorf("go run . -json reported %v lessons, want %v", len(results), len(List()))
+	}
+}
+
+// timers waits 10ms for its first timer
+// and clocks comes after it
+func TestJSONOutputAfterTimeout(t *testing.T) {
+	stdout, _, err := runMain(t, "-json -tags time -timeout 5ms")
+	if err == nil {
+		t.Errorf("go run . -json -timeout 5ms did not fail")
+	}
+	var results []lessonResult
+	if err := json.Unmarshal([]byte(stdout), &results); err != nil {
+		t.Fatalf("go run . -json is not json: %v\n%v", err, stdout)
+	}
+	want := []string{"", "timed out after 5ms", "not run: an earlier lesson timed out"}
+	if len(results) != len(want) {
+		t.Fatalf("go run . -json reported %v lessons, want %v", len(results), len(want))
+	}
+	for i, result := range results {
+		if result.Error != want[i] {
+			t.Errorf("%v error = %q, want %q", result.Lesson, result.Error, want[i])
+		}
+	}
+}
+
+func TestTextOutputAfterTimeout(t *testing.T) {
+	stdout, stderr, err := runMain(t, "-tags time -timeout 5ms")
+	if err == nil {
+		t.Errorf("go run . -timeout 5ms did not fail")
+	}
+	if strings.Contains(stdout, "clocks") {
+		t.Errorf("go run . -timeout 5ms ran clocks after timers timed out:\n%v", stdout)
+	}
+	want := "clocks          not run: an earlier lesson timed out"
+	if !strings.Contains(stderr, want) {
+		t.Errorf("go run . -timeout 5ms printed %q, want %q", stderr, want)
+	}
+}
+
+func TestPrintResults(t *testing.T) {
+	var output strings.Builder
+	if err := printResults(&output, []lessonResult{{"variables", 1.5, "7\n", ""}}); err != nil {
+		t.Fatal(err)
+	}
+
+	var decoded []map[string]interface{}
+	if err := json.Unmarshal([]byte(output.String()), &decoded); err != nil {
+		t.Fatalf("printResults() is not json: %v\n%v", err, output.String())
+	}
+	want := map[string]interface{}{"lesson": "variables", "durationMs": 1.5, "output": "7\n", "error": ""}
+	if len(decoded) != 1 || len(decoded[0]) != len(want) {
+		t.Fatalf("printResults() = %v, want %v", decoded, want)
+	}
+	for key, value := range want {
+		if decoded[0][key] != value {
+			t.Errorf("printResults() %v = %v, want %v", key, decoded[0][key], value)
+		}
+	}
+
+	output.Reset()
+	if err := printResults(&output, nil); err != nil || output.String() != "[]\n" {
+		t.Errorf("printResults(nil) = %q, %v, want []", output.String(), err)
+	}
+}
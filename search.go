@@ -0,0 +1,46 @@
+package main
+
+import "strings"
+
+// finding where a topic is demonstrated
+// go run . -search mutex
+type searchMatch struct {
+	Lesson Lesson
+	File   string
+	Line   int
+	Text   string
+}
+
+// matching is case insensitive
+// against the name, description and keywords of a lesson
+// then against every line of its source
+// a lesson with no source is matched on its metadata only
+func search(lessons []Lesson, query string) []searchMatch {
+	query = strings.ToLower(query)
+	var matches []searchMatch
+	for _, lesson := range lessons {
+		code, err := lessonSource(lesson)
+
+		metadata := append([]string{lesson.Name, lesson.Description}, lesson.Keywords...)
+		for _, text := range metadata {
+			if strings.Contains(strings.ToLower(text), query) {
+				matches = append(matches, searchMatch{lesson, code.File, code.Line, lesson.Description})
+				break
+			}
+		}
+		if err != nil {
+			continue
+		}
+
+		for i, line := range strings.Split(code.Source, "\n") {
+			comment := strings.TrimSpace(line)
+			if !strings.HasPrefix(comment, "//") {
+				continue
+			}
+			if strings.Contains(strings.ToLower(comment), query) {
+				matches = append(matches, searchMatch{lesson, code.File, code.Line + i, comment})
+			}
+		}
+	}
+	return matches
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestSearch(t *testing.T) {
+	lessons := []Lesson{
+		{"slices", "auto growing slices", slicesLesson, []string{"append"}, nil, nil},
+		{"sync", "mutexes and sync.Once", syncLesson, []string{"sync.Mutex"}, nil, nil},
+	}
+
+	matches := search(lessons, "MUTEX")
+	if len(matches) < 2 {
+		t.Fatalf("found %v matches, want the metadata and a comment", len(matches))
+	}
+	for _, match := range matches {
+		if match.Lesson.Name != "sync" {
+			t.Errorf("matched lesson %v, want sync", match.Lesson.Name)
+		}
+		if filepath.Base(match.File) != "concurrency.go" || match.Line == 0 {
+			t.Errorf("matched at %v:%v, want a line of concurrency.go", match.File, match.Line)
+		}
+	}
+	if matches[1].Text != "// a mutex allows one goroutine at a time" {
+		t.Errorf("matched comment %q", matches[1].Text)
+	}
+
+	if matches := search(lessons, "no such topic"); len(matches) != 0 {
+		t.Errorf("found %v matches for an unknown topic", len(matches))
+	}
+}
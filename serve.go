@@ -0,0 +1,181 @@
+package main
+
+import (
+	"go/scanner"
+	"go/token"
+	"html"
+	"html/template"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// serving the lessons over http
+// go run . -serve :8080
+
+// templates are parsed once
+// and escape the values they render
+var indexTemplate = template.Must(template.New("index").Parse(`<!DOCTYPE html>
+<html>
+<head><title>Learning Go</title></head>
+<body>
+<h1>Learning Go</h1>
+<p><a href="/site/">the embedded site</a></p>
+<ul>
+{{range .}}<li><a href="/lessons/{{.Name}}">{{.Name}}</a> {{.Description}}</li>
+{{end}}</ul>
+</body>
+</html>
+`))
+
+var lessonTemplate = template.Must(template.New("lesson").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<title>{{.Name}}</title>
+<style>
+body { font-family: sans-serif; }
+main { display: flex; gap: 2em; }
+pre { background: #f6f8fa; padding: 1em; }
+.comment { color: #6a737d; font-style: italic; }
+.keyword { color: #d73a49; font-weight: bold; }
+.string { color: #032f62; }
+</style>
+</head>
+<body>
+<p><a href="/">lessons</a></p>
+<h1>{{.Name}}</h1>
+<p>{{.Description}}</p>
+<main>
+<section><h2>source</h2><pre>{{.Source}}</pre></section>
+<section><h2>output</h2><pre>{{.Output}}</pre></section>
+</main>
+</body>
+</html>
+`))
+
+type lessonPage struct {
+	Name        string
+	Description string
+	Source      template.HTML
+	Output      string
+}
+
+// outputs are captured once
+// requests are served concurrently
+// so the cache is protected by a mutex
+type lessonServer struct {
+	mutex   sync.Mutex
+	outputs map[string]string
+}
+
+func newLessonServer() http.Handler {
+	server := &lessonServer{outputs: make(map[string]string)}
+
+	// patterns can match a method
+	// and capture path segments
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /{$}", server.index)
+	mux.HandleFunc("GET /lessons/{name}", server.lesson)
+	mux.Handle("GET /site/", siteHandler())
+	return mux
+}
+
+func (s *lessonServer) index(w http.ResponseWriter, r *http.Request) {
+	if err := indexTemplate.Execute(w, List()); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+func (s *lessonServer) lesson(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("name")
+	for _, lesson := range List() {
+		if lesson.Name != name {
+			continue
+		}
+
+		code, err := lessonSource(lesson)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		output, err := s.output(lesson)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		page := lessonPage{lesson.Name, lesson.Description, highlight(code.Source), output}
+		if err := lessonTemplate.Execute(w, page); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+	http.NotFound(w, r)
+}
+
+func (s *lessonServer) output(lesson Lesson) (string, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if output, ok := s.outputs[lesson.Name]; ok {
+		return output, nil
+	}
+	output, err := captureOutput(lesson.Run)
+	if err != nil {
+		return "", err
+	}
+	s.outputs[lesson.Name] = output
+	return output, nil
+}
+
+// highlighting go source
+// by wrapping its tokens in styled spans
+func highlight(source string) template.HTML {
+	fileSet := token.NewFileSet()
+	file := fileSet.AddFile("", fileSet.Base(), len(source))
+
+	var s scanner.Scanner
+	s.Init(file, []byte(source), nil, scanner.ScanComments)
+
+	var highlighted strings.Builder
+	offset := 0
+	for {
+		position, tok, literal := s.Scan()
+		if tok == token.EOF {
+			break
+		}
+
+		// automatically inserted semicolons
+		// are not part of the source
+		if tok == token.SEMICOLON && literal == "\n" {
+			continue
+		}
+
+		start := file.Offset(position)
+		end := start + len(tok.String())
+		if literal != "" {
+			end = start + len(literal)
+		}
+
+		class := ""
+		switch {
+		case tok == token.COMMENT:
+			class = "comment"
+		case tok.IsKeyword():
+			class = "keyword"
+		case tok == token.STRING || tok == token.CHAR:
+			class = "string"
+		}
+
+		highlighted.WriteString(html.EscapeString(source[offset:start]))
+		if class != "" {
+			highlighted.WriteString(`<span class="` + class + `">`)
+			highlighted.WriteString(html.EscapeString(source[start:end]))
+			highlighted.WriteString(`</span>`)
+		} else {
+			highlighted.WriteString(html.EscapeString(source[start:end]))
+		}
+		offset = end
+	}
+	highlighted.WriteString(html.EscapeString(source[offset:]))
+	return template.HTML(highlighted.String())
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestServeIndex(t *testing.T) {
+	response := httptest.NewRecorder()
+	newLessonServer().ServeHTTP(response, httptest.NewRequest("GET", "/", nil))
+
+	if response.Code != http.StatusOK {
+		t.Fatalf("status %v, want 200", response.Code)
+	}
+	if body := response.Body.String(); !strings.Contains(body, `<a href="/lessons/slices">slices</a>`) {
+		t.Errorf("index does not link to the slices lesson:\n%v", body)
+	}
+}
+
+func TestServeLesson(t *testing.T) {
+	response := httptest.NewRecorder()
+	newLessonServer().ServeHTTP(response, httptest.NewRequest("GET", "/lessons/variables", nil))
+
+	if response.Code != http.StatusOK {
+		t.Fatalf("status %v, want 200", response.Code)
+	}
+	body := response.Body.String()
+	if !strings.Contains(body, `<span class="comment">// variable declarations</span>`) {
+		t.Errorf("lesson page does not highlight the source:\n%v", body)
+	}
+	if !strings.Contains(body, "<pre>7\n</pre>") {
+		t.Errorf("lesson page does not show the output:\n%v", body)
+	}
+}
+
+func TestServeUnknownLesson(t *testing.T) {
+	response := httptest.NewRecorder()
+	newLessonServer().ServeHTTP(response, httptest.NewRequest("GET", "/lessons/unknown", nil))
+
+	if response.Code != http.StatusNotFound {
+		t.Errorf("status %v, want 404", response.Code)
+	}
+}
+
+func TestServeEmbeddedSite(t *testing.T) {
+	var tests = []struct {
+		path string
+		code int
+		body string
+	}{
+		{"/site/", http.StatusOK, "<h1>served from the binary</h1>"},
+		{"/site/style.css", http.StatusOK, "h1 { color: teal; }"},
+		{"/site/missing.css", http.StatusNotFound, "404 page not found"},
+	}
+	for _, test := range tests {
+		response := httptest.NewRecorder()
+		newLessonServer().ServeHTTP(response, httptest.NewRequest("GET", test.path, nil))
+		if response.Code != test.code || !strings.Contains(response.Body.String(), test.body) {
+			t.Errorf("GET %v = %v %q, want %v containing %q", test.path, response.Code, response.Body.String(), test.code, test.body)
+		}
+	}
+}
+
+func TestHighlight(t *testing.T) {
+	got := string(highlight("func a() {\n\t// <b>\n\treturn \"x\"\n}"))
+	want := "<span class=\"keyword\">func</span> a() {\n\t<span class=\"comment\">// &lt;b&gt;</span>\n\t<span class=\"keyword\">return</span> <span class=\"string\">&#34;x&#34;</span>\n}"
+	if got != want {
+		t.Errorf("highlight() = %q, want %q", got, want)
+	}
+}
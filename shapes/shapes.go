@@ -0,0 +1,66 @@
+// shapes lives in a package of its own
+// to show what other packages can see
+// names starting with an upper cased letter are exported
+// all the others stay inside the package
+package shapes
+
+import (
+	"errors"
+	"math"
+)
+
+type Shape interface {
+	Area() float64
+	Perimeter() float64
+}
+
+// exported fields can be set by anyone
+type Circle struct {
+	Radius float64
+}
+
+func (c Circle) Area() float64 {
+	return round(math.Pi * c.Radius * c.Radius)
+}
+
+func (c Circle) Perimeter() float64 {
+	return round(2 * math.Pi * c.Radius)
+}
+
+// unexported fields can only be set
+// through the constructor which validates them
+type Rectangle struct {
+	width  float64
+	height float64
+}
+
+var ErrNotPositive = errors.New("sides must be positive")
+
+func NewRectangle(width, height float64) (*Rectangle, error) {
+	if width <= 0 || height <= 0 {
+		return nil, ErrNotPositive
+	}
+	return &Rectangle{width, height}, nil
+}
+
+func (r *Rectangle) Width() float64 {
+	return r.width
+}
+
+func (r *Rectangle) Height() float64 {
+	return r.height
+}
+
+func (r *Rectangle) Area() float64 {
+	return round(r.width * r.height)
+}
+
+func (r *Rectangle) Perimeter() float64 {
+	return round(2 * (r.width + r.height))
+}
+
+// unexported helpers can change
+// without breaking other packages
+func round(value float64) float64 {
+	return math.Round(value*100) / 100
+}
@@ -0,0 +1,38 @@
+package shapes
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestAreasAndPerimeters(t *testing.T) {
+	rectangle, err := NewRectangle(3, 4)
+	if err != nil {
+		t.Fatalf("NewRectangle(3, 4) failed: %v", err)
+	}
+	tests := []struct {
+		shape     Shape
+		area      float64
+		perimeter float64
+	}{
+		{Circle{1}, 3.14, 6.28},
+		{Circle{2}, 12.57, 12.57},
+		{rectangle, 12, 14},
+	}
+	for _, test := range tests {
+		if got := test.shape.Area(); got != test.area {
+			t.Errorf("%+v.Area() = %v, want %v", test.shape, got, test.area)
+		}
+		if got := test.shape.Perimeter(); got != test.perimeter {
+			t.Errorf("%+v.Perimeter() = %v, want %v", test.shape, got, test.perimeter)
+		}
+	}
+}
+
+func TestNewRectangleRejectsBadSides(t *testing.T) {
+	for _, sides := range [][2]float64{{0, 1}, {1, -1}} {
+		if _, err := NewRectangle(sides[0], sides[1]); !errors.Is(err, ErrNotPositive) {
+			t.Errorf("NewRectangle(%v, %v) = %v, want ErrNotPositive", sides[0], sides[1], err)
+		}
+	}
+}
@@ -0,0 +1,258 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// sharing a lesson on the go playground
+// go run . -share slices
+// the lesson is extracted with the declarations it uses
+// into a program that runs on its own
+var playgroundShareURL = "https://play.golang.org/share"
+
+const playgroundURL = "https://go.dev/play/p/"
+
+func shareLesson(lesson Lesson) (string, error) {
+	snippet, err := lessonSnippet(lesson)
+	if err != nil {
+		return "", err
+	}
+
+	response, err := http.Post(playgroundShareURL, "text/plain; charset=utf-8", strings.NewReader(snippet))
+	if err != nil {
+		return "", fmt.Errorf("while sharing lesson %v: %w", lesson.Name, err)
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return "", fmt.Errorf("while sharing lesson %v: %w", lesson.Name, err)
+	}
+	if response.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("while sharing lesson %v: %v %s", lesson.Name, response.Status, bytes.TrimSpace(body))
+	}
+	return playgroundURL + string(bytes.TrimSpace(body)), nil
+}
+
+// a top level declaration of the package
+type packageDeclaration struct {
+	node    ast.Decl
+	content []byte
+	fileSet *token.FileSet
+	imports map[string]string
+}
+
+// building a main package
+// from the lesson function and every declaration
+// it needs directly or indirectly
+func lessonSnippet(lesson Lesson) (string, error) {
+	if slices.Contains(lesson.Tags, "cgo") {
+		return "", fmt.Errorf("lesson %v uses cgo and cannot run on the playground", lesson.Name)
+	}
+
+	function := runtime.FuncForPC(reflect.ValueOf(lesson.Run).Pointer())
+	if function == nil {
+		return "", fmt.Errorf("no function found for lesson %v", lesson.Name)
+	}
+	file, _ := function.FileLine(function.Entry())
+	name := function.Name()[strings.LastIndex(function.Name(), ".")+1:]
+
+	declarations, methods, err := parsePackage(filepath.Dir(file))
+	if err != nil {
+		return "", err
+	}
+
+	// following the identifiers used
+	// by the included declarations
+	// with the methods of the included types
+	included := make(map[*packageDeclaration]bool)
+	imports := make(map[string]bool)
+	pending := []string{name}
+	for len(pending) > 0 {
+		name := pending[0]
+		pending = pending[1:]
+
+		found := []*packageDeclaration{declarations[name]}
+		found = append(found, methods[name]...)
+		for _, declaration := range found {
+			if declaration == nil || included[declaration] {
+				continue
+			}
+			included[declaration] = true
+			ast.Inspect(declaration.node, func(node ast.Node) bool {
+				switch node := node.(type) {
+				case *ast.SelectorExpr:
+					// fmt.Print names the fmt function
+					// not a declaration of the package
+					if x, ok := node.X.(*ast.Ident); ok {
+						if path, ok := declaration.imports[x.Name]; ok {
+							imports[path] = true
+							return false
+						}
+					}
+				case *ast.Ident:
+					if _, ok := declarations[node.Name]; ok {
+						pending = append(pending, node.Name)
+					}
+				}
+				return true
+			})
+		}
+	}
+	var snippet bytes.Buffer
+	snippet.WriteString("package main\n\nimport (\n")
+	var paths []string
+	for path := range imports {
+		paths = append(paths, strconv.Quote(path))
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		fmt.Fprintf(&snippet, "\t%v\n", path)
+	}
+	fmt.Fprintf(&snippet, ")\n\nfunc main() {\n\t%v()\n}\n", name)
+
+	// keeping the declarations in source order
+	var ordered []*packageDeclaration
+	for declaration := range included {
+		ordered = append(ordered, declaration)
+	}
+	sort.Slice(ordered, func(i, j int) bool {
+		first := ordered[i].fileSet.Position(ordered[i].node.Pos())
+		second := ordered[j].fileSet.Position(ordered[j].node.Pos())
+		if first.Filename != second.Filename {
+			return first.Filename < second.Filename
+		}
+		return first.Offset < second.Offset
+	})
+	for _, declaration := range ordered {
+		start := declaration.node.Pos()
+		switch node := declaration.node.(type) {
+		case *ast.FuncDecl:
+			if node.Doc != nil {
+				start = node.Doc.Pos()
+			}
+		case *ast.GenDecl:
+			if node.Doc != nil {
+				start = node.Doc.Pos()
+			}
+		}
+		from := declaration.fileSet.Position(start).Offset
+		to := declaration.fileSet.Position(declaration.node.End()).Offset
+		fmt.Fprintf(&snippet, "\n%s\n", declaration.content[from:to])
+	}
+
+	formatted, err := format.Source(snippet.Bytes())
+	if err != nil {
+		return "", fmt.Errorf("while formatting lesson %v: %w", lesson.Name, err)
+	}
+	return string(formatted), nil
+}
+
+// the playground builds for linux/amd64 without cgo
+// whatever machine the lesson is shared from
+var playgroundContext = func() build.Context {
+	context := build.Default
+	context.GOOS = "linux"
+	context.GOARCH = "amd64"
+	context.CgoEnabled = false
+	return context
+}()
+
+// the declarations of the package by name
+// and the methods by the name of their receiver type
+// init functions register the lessons and are left out
+// files the playground would not build are skipped
+// they declare the same names as the files it builds
+// blank variables are left out like init functions
+func parsePackage(dir string) (map[string]*packageDeclaration, map[string][]*packageDeclaration, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	declarations := make(map[string]*packageDeclaration)
+	methods := make(map[string][]*packageDeclaration)
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		if match, err := playgroundContext.MatchFile(dir, filepath.Base(path)); err != nil || !match {
+			continue
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, nil, err
+		}
+		fileSet := token.NewFileSet()
+		parsed, err := parser.ParseFile(fileSet, path, content, parser.ParseComments)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		imports := make(map[string]string)
+		for _, spec := range parsed.Imports {
+			path, _ := strconv.Unquote(spec.Path.Value)
+			name := path[strings.LastIndex(path, "/")+1:]
+			if spec.Name != nil {
+				name = spec.Name.Name
+			}
+			imports[name] = path
+		}
+
+		for _, node := range parsed.Decls {
+			declaration := &packageDeclaration{node, content, fileSet, imports}
+			switch node := node.(type) {
+			case *ast.FuncDecl:
+				if node.Recv == nil {
+					if node.Name.Name != "init" {
+						declarations[node.Name.Name] = declaration
+					}
+					continue
+				}
+				receiver := node.Recv.List[0].Type
+				if star, ok := receiver.(*ast.StarExpr); ok {
+					receiver = star.X
+				}
+				if index, ok := receiver.(*ast.IndexExpr); ok {
+					receiver = index.X
+				}
+				if index, ok := receiver.(*ast.IndexListExpr); ok {
+					receiver = index.X
+				}
+				if ident, ok := receiver.(*ast.Ident); ok {
+					methods[ident.Name] = append(methods[ident.Name], declaration)
+				}
+			case *ast.GenDecl:
+				for _, spec := range node.Specs {
+					switch spec := spec.(type) {
+					case *ast.TypeSpec:
+						declarations[spec.Name.Name] = declaration
+					case *ast.ValueSpec:
+						for _, name := range spec.Names {
+							if name.Name != "_" {
+								declarations[name.Name] = declaration
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return declarations, methods, nil
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestLessonSnippet(t *testing.T) {
+	lesson, _ := Find("methods")
+	snippet, err := lessonSnippet(lesson)
+	if err != nil {
+		t.Fatalf("lessonSnippet failed: %v", err)
+	}
+	for _, want := range []string{
+		"package main",
+		"\"fmt\"",
+		"func main() {\n\tmethodsLesson()\n}",
+		"func methodsLesson() {",
+		"type Animal struct {",
+		"func (a *Animal) GrowLeg() {",
+		"func boundLegsCounts()",
+	} {
+		if !strings.Contains(snippet, want) {
+			t.Errorf("snippet does not contain %q:\n%v", want, snippet)
+		}
+	}
+	if strings.Contains(snippet, "func init()") || strings.Contains(snippet, "Cake") {
+		t.Errorf("snippet contains unused declarations:\n%v", snippet)
+	}
+
+	cgo, _ := Find("cgo")
+	if _, err := lessonSnippet(cgo); err == nil {
+		t.Error("lessonSnippet(cgo) did not fail")
+	}
+}
+
+func TestLessonSnippetFollowsBuildConstraints(t *testing.T) {
+	lesson, _ := Find("build-tags")
+	snippet, err := lessonSnippet(lesson)
+	if err != nil {
+		t.Fatalf("lessonSnippet failed: %v", err)
+	}
+	for _, name := range []string{"platformFile", "edition", "cgoEnabled"} {
+		if count := strings.Count(snippet, "const "+name+" "); count != 1 {
+			t.Errorf("snippet declares %v %v times, want once:\n%v", name, count, snippet)
+		}
+	}
+	for _, want := range []string{`const platformFile = "platform_linux.go"`, `const cgoEnabled = false`} {
+		if !strings.Contains(snippet, want) {
+			t.Errorf("snippet does not contain %q:\n%v", want, snippet)
+		}
+	}
+	if strings.Contains(snippet, `"C"`) || strings.Contains(snippet, "initorder") {
+		t.Errorf("snippet contains declarations the playground cannot build:\n%v", snippet)
+	}
+}
+
+func TestShareLesson(t *testing.T) {
+	var shared string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		shared = string(body)
+		io.WriteString(w, "abc123\n")
+	}))
+	defer server.Close()
+
+	playgroundShareURLReal := playgroundShareURL
+	defer func() { playgroundShareURL = playgroundShareURLReal }()
+	playgroundShareURL = server.URL
+
+	lesson, _ := Find("variables")
+	url, err := shareLesson(lesson)
+	if err != nil {
+		t.Fatalf("shareLesson failed: %v", err)
+	}
+	if url != "https://go.dev/play/p/abc123" {
+		t.Errorf("shareLesson() = %v, want https://go.dev/play/p/abc123", url)
+	}
+	if !strings.Contains(shared, "func variablesLesson() {") {
+		t.Errorf("shared snippet is not the lesson:\n%v", shared)
+	}
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"reflect"
+	"runtime"
+	"strings"
+)
+
+// the source code of a lesson
+type lessonCode struct {
+	File   string
+	Line   int
+	Source string
+}
+
+// locating the source of a lesson
+// from the address of its run function
+// only works when the source files
+// are where the binary was built from
+func lessonSource(lesson Lesson) (lessonCode, error) {
+	function := runtime.FuncForPC(reflect.ValueOf(lesson.Run).Pointer())
+	if function == nil {
+		return lessonCode{}, fmt.Errorf("no function found for lesson %v", lesson.Name)
+	}
+	file, line := function.FileLine(function.Entry())
+
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return lessonCode{}, fmt.Errorf("while reading the source of lesson %v: %w", lesson.Name, err)
+	}
+
+	fileSet := token.NewFileSet()
+	parsed, err := parser.ParseFile(fileSet, file, content, parser.ParseComments)
+	if err != nil {
+		return lessonCode{}, fmt.Errorf("while parsing the source of lesson %v: %w", lesson.Name, err)
+	}
+
+	// the declaration spanning the entry line
+	for _, declaration := range parsed.Decls {
+		function, ok := declaration.(*ast.FuncDecl)
+		if !ok {
+			continue
+		}
+		start := fileSet.Position(function.Pos())
+		end := fileSet.Position(function.End())
+		if start.Line <= line && line <= end.Line {
+			return lessonCode{file, start.Line, string(content[start.Offset:end.Offset])}, nil
+		}
+	}
+	return lessonCode{}, fmt.Errorf("no declaration found for lesson %v in %v", lesson.Name, file)
+}
+
+// a section is a comment
+// followed by the code it explains
+// returns the index of the first line of each section
+func sectionStarts(lines []string) []int {
+	starts := []int{0}
+	for i := 1; i < len(lines); i++ {
+		previous := strings.TrimSpace(lines[i-1])
+		if strings.HasPrefix(strings.TrimSpace(lines[i]), "//") && previous == "" {
+			starts = append(starts, i)
+		}
+	}
+	return starts
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLessonSource(t *testing.T) {
+	code, err := lessonSource(Lesson{"slices", "", slicesLesson, nil, nil, nil})
+	if err != nil {
+		t.Fatalf("lessonSource failed: %v", err)
+	}
+	if filepath.Base(code.File) != "collections.go" {
+		t.Errorf("lesson found in %v, want collections.go", code.File)
+	}
+	if !strings.HasPrefix(code.Source, "func slicesLesson() {") || !strings.HasSuffix(code.Source, "}") {
+		t.Errorf("lesson source is not the whole function:\n%v", code.Source)
+	}
+}
+
+func TestSectionStarts(t *testing.T) {
+	lines := strings.Split(`func lesson() {
+
+	// first section
+	first()
+
+	// second section
+	// on two lines
+	second()
+	third()
+}`, "\n")
+	if got, want := sectionStarts(lines), []int{0, 2, 5}; !reflect.DeepEqual(got, want) {
+		t.Errorf("sectionStarts() = %v, want %v", got, want)
+	}
+}
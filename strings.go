@@ -0,0 +1,252 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+func init() {
+	RegisterChapter(Chapter{3, "Strings", []Lesson{
+		{"strings", "bytes, runes and string building", stringsLesson, []string{"string", "byte", "rune", "utf8", "unicode", "bytes.Buffer", "strings.Builder", "normalization", "NFC", "NFD", "x/text", "EqualFold", "case folding"}, []string{"slices"}, []string{"beginner", "strings"}},
+		{"formatting", "fmt verbs, widths and precisions", formattingLesson, []string{"fmt", "Printf", "Sprintf", "Fprintf", "Errorf", "%v", "%+v", "%#v", "%T", "%q", "%x", "verb"}, []string{"strings", "structures"}, []string{"beginner", "strings"}},
+	}})
+}
+
+// strings are immutable so += copies
+// everything built so far on every piece
+func concatenatePlus(pieces []string) string {
+	result := ""
+	for _, piece := range pieces {
+		result += piece
+	}
+	return result
+}
+
+// a builder grows its byte slice
+// and converts it without a final copy
+func concatenateBuilder(pieces []string) string {
+	var builder strings.Builder
+	for _, piece := range pieces {
+		builder.WriteString(piece)
+	}
+	return builder.String()
+}
+
+// a buffer grows the same way
+// but String() copies the bytes once more
+func concatenateBuffer(pieces []string) string {
+	var buffer bytes.Buffer
+	for _, piece := range pieces {
+		buffer.WriteString(piece)
+	}
+	return buffer.String()
+}
+
+// normalizing first makes composed and decomposed
+// accents compare equal
+// EqualFold then ignores the case
+func sameWord(a, b string) bool {
+	return strings.EqualFold(norm.NFC.String(a), norm.NFC.String(b))
+}
+
+// join knows the pieces upfront
+// and allocates the exact size once
+func concatenateJoin(pieces []string) string {
+	return strings.Join(pieces, "")
+}
+
+func stringsLesson() {
+
+	// strings are immutable sequences of bytes
+	trace()
+	greek := "some greek: Τη γλώσσα μου έδωσαν"
+
+	// the index operation returns a byte
+	trace()
+	fmt.Println(greek[0])
+	// Output:
+	// 115
+
+	// the substring operation returns a string
+	trace()
+	fmt.Println(greek[5:10])
+	// Output:
+	// greek
+
+	// strings can be decoded as bytes
+	trace()
+	greekBytes := []byte(greek)
+	fmt.Printf("greek decoded as bytes: %v\n", greekBytes)
+	// Output:
+	// greek decoded as bytes: [115 111 109 101 32 103 114 101 101 107 58 32 206 164 206 183 32 206 179 206 187 207 142 207 131 207 131 206 177 32 206 188 206 191 207 133 32 206 173 206 180 207 137 207 131 206 177 206 189]
+
+	// or as utf8 unicode code points
+	// these are named runes and are int32
+	trace()
+	greekRunes := []rune(greek)
+	fmt.Printf("greek decoded as runes: %v\n", greekRunes)
+	// Output:
+	// greek decoded as runes: [115 111 109 101 32 103 114 101 101 107 58 32 932 951 32 947 955 974 963 963 945 32 956 959 965 32 941 948 969 963 945 957]
+
+	// fancy decoding is required to
+	// index the runes inside a string
+	trace()
+	rune, bytesCount := utf8.DecodeRuneInString(greek[14:])
+	runesCount := utf8.RuneCountInString(greek)
+	fmt.Printf("found rune %c spanning %v bytes\n", rune, bytesCount)
+	fmt.Printf("found %v runes\n", runesCount)
+	// Output:
+	// found rune η spanning 2 bytes
+	// found 32 runes
+
+	// an accent can be a single composed rune
+	// or a letter followed by a combining mark
+	// both print the same but compare unequal
+	trace()
+	composed := "caf\u00e9"
+	decomposed := "cafe\u0301"
+	fmt.Printf("%v %v equal: %v\n", composed, decomposed, composed == decomposed)
+	fmt.Printf("runes: %v and %v\n", utf8.RuneCountInString(composed), utf8.RuneCountInString(decomposed))
+	// Output:
+	// café café equal: false
+	// runes: 4 and 5
+
+	// normalization picks one of the forms
+	// NFC composes and NFD decomposes
+	trace()
+	fmt.Printf("nfc equal: %v\n", norm.NFC.String(decomposed) == composed)
+	fmt.Printf("nfd equal: %v\n", norm.NFD.String(composed) == decomposed)
+	fmt.Printf("greek runes: %v, decomposed: %v\n", runesCount, utf8.RuneCountInString(norm.NFD.String(greek)))
+	// Output:
+	// nfc equal: true
+	// nfd equal: true
+	// greek runes: 32, decomposed: 34
+
+	// EqualFold compares ignoring the case
+	// it knows both lower case sigmas fold to Σ
+	// where ToLower only produces σ
+	trace()
+	fmt.Printf("fold: %v\n", strings.EqualFold("γλώσσα", "ΓΛΏΣΣΑ"))
+	fmt.Printf("sigmas fold: %v, lower: %v\n", strings.EqualFold("ς", "Σ"), strings.ToLower("Σ") == "ς")
+	// Output:
+	// fold: true
+	// sigmas fold: true, lower: false
+
+	// but it does not normalize
+	trace()
+	fmt.Printf("fold: %v, same word: %v\n", strings.EqualFold("CAF\u00c9", decomposed), sameWord("CAF\u00c9", decomposed))
+	// Output:
+	// fold: false, same word: true
+
+	// iterating is done over runes
+	trace()
+	for range greek {
+	}
+
+	// efficient string building using a buffer
+	trace()
+	var buffer bytes.Buffer
+	buffer.WriteByte('a')
+	buffer.WriteRune('λ')
+	buffer.WriteString("yeah")
+	fmt.Println(buffer.String())
+	// Output:
+	// aλyeah
+
+	// or a strings builder
+	// see the concatenation benchmarks
+	trace()
+	fmt.Println(concatenateBuilder([]string{"a", "λ", "yeah"}))
+	// Output:
+	// aλyeah
+}
+
+func formattingLesson() {
+
+	// %v is the default format
+	// %+v adds the field names
+	// %#v prints the value as Go syntax
+	// %T prints the type
+	trace()
+	cookie := Cookie{10, "Chocolate", 5}
+	fmt.Printf("%v\n", cookie)
+	fmt.Printf("%+v\n", cookie)
+	fmt.Printf("%#v\n", cookie)
+	fmt.Printf("%T\n", cookie)
+	// Output:
+	// {10 Chocolate 5}
+	// {Size:10 Flavour:Chocolate Rating:5}
+	// main.Cookie{Size:10, Flavour:"Chocolate", Rating:5}
+	// main.Cookie
+
+	// pointers to structs print with an &
+	trace()
+	fmt.Printf("%v\n", &cookie)
+	// Output:
+	// &{10 Chocolate 5}
+
+	// %q quotes and escapes strings and runes
+	trace()
+	fmt.Printf("%q %q\n", "tab\there", 'é')
+	// Output:
+	// "tab\there" 'é'
+
+	// %x prints hexadecimal
+	// of integers and of the bytes of strings
+	// %X uses upper case
+	trace()
+	fmt.Printf("%x %X %x\n", 255, 255, "Go")
+	// Output:
+	// ff FF 476f
+
+	// width and precision
+	// %08.3f pads with zeros to 8 characters
+	// and keeps 3 decimals
+	trace()
+	fmt.Printf("%08.3f\n", 3.14159)
+	fmt.Printf("%.2f %e\n", 3.14159, 1234.5678)
+	// Output:
+	// 0003.142
+	// 3.14 1.234568e+03
+
+	// padding aligns columns
+	// a - aligns to the left
+	trace()
+	fmt.Printf("|%6s|%-6s|%4d|%-4d|\n", "go", "go", 42, 42)
+	// Output:
+	// |    go|go    |  42|42  |
+
+	// %% prints a percent sign
+	trace()
+	fmt.Printf("%d%%\n", 50)
+	// Output:
+	// 50%
+
+	// Sprintf returns the string
+	// Fprintf writes to any io.Writer
+	// Errorf returns an error
+	trace()
+	label := fmt.Sprintf("%v cookie", cookie.Flavour)
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "a %v of size %v", label, cookie.Size)
+	err := fmt.Errorf("no more %v", label)
+	fmt.Println(builder.String())
+	fmt.Println(err)
+	// Output:
+	// a Chocolate cookie of size 10
+	// no more Chocolate cookie
+
+	// Print adds spaces between operands
+	// only when neither is a string
+	// Println always adds them
+	trace()
+	fmt.Print("a", "b", 1, 2, "\n")
+	fmt.Println("a", "b", 1, 2)
+	// Output:
+	// ab1 2
+	// a b 1 2
+}
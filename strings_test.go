@@ -0,0 +1,70 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+// comparing string concatenations
+// of 1000 pieces from fastest to slowest
+// strings.Join, strings.Builder, bytes.Buffer
+// and far behind += which allocates on every piece
+var concatenationPieces = strings.Split(strings.Repeat("piece ", 1000), " ")[:1000]
+
+func TestConcatenationsAgree(t *testing.T) {
+	want := concatenatePlus(concatenationPieces)
+	for name, concatenate := range map[string]func([]string) string{
+		"builder": concatenateBuilder,
+		"buffer":  concatenateBuffer,
+		"join":    concatenateJoin,
+	} {
+		if got := concatenate(concatenationPieces); got != want {
+			t.Errorf("%v concatenation differs from +=", name)
+		}
+	}
+}
+
+func TestSameWord(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{"caf\u00e9", "cafe\u0301", true},
+		{"CAF\u00c9", "cafe\u0301", true},
+		{"γλώσσα", "ΓΛΏΣΣΑ", true},
+		{"cafe", "caf\u00e9", false},
+	}
+	for _, test := range tests {
+		if got := sameWord(test.a, test.b); got != test.want {
+			t.Errorf("sameWord(%q, %q) = %v, want %v", test.a, test.b, got, test.want)
+		}
+	}
+}
+
+func BenchmarkConcatenatePlus(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		concatenatePlus(concatenationPieces)
+	}
+}
+
+func BenchmarkConcatenateBuilder(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		concatenateBuilder(concatenationPieces)
+	}
+}
+
+func BenchmarkConcatenateBuffer(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		concatenateBuffer(concatenationPieces)
+	}
+}
+
+func BenchmarkConcatenateJoin(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		concatenateJoin(concatenationPieces)
+	}
+}
@@ -0,0 +1,451 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+func init() {
+	RegisterChapter(Chapter{5, "Structures", []Lesson{
+		{"structures", "structures and pointers", structuresLesson, []string{"struct", "pointer", "new", "anonymous struct"}, []string{"types"}, []string{"beginner", "structs"}},
+		{"methods", "methods, method expressions and values", methodsLesson, []string{"method", "receiver", "method expression", "method value", "method set", "pointer receiver", "value receiver", "addressable"}, []string{"structures", "functions"}, []string{"beginner", "structs"}},
+		{"embedding", "structure embedding and shadowing", embeddingLesson, []string{"embedding", "promotion", "shadowing"}, []string{"methods"}, []string{"intermediate", "structs"}},
+		{"tags", "struct tags read with reflection", tagsLesson, []string{"struct tag", "json", "validate", "reflect.StructTag", "Tag.Get", "Tag.Lookup"}, []string{"structures", "custom-errors"}, []string{"intermediate", "structs", "reflection"}},
+		{"options", "functional options and config structs", optionsLesson, []string{"functional options", "Option", "constructor", "variadic", "config struct", "defaults"}, []string{"structures", "functions"}, []string{"intermediate", "structs", "patterns"}},
+		{"builders", "fluent builders and struct literals", buildersLesson, []string{"builder", "fluent", "chaining", "Build", "struct literal"}, []string{"options", "custom-errors"}, []string{"intermediate", "structs", "patterns"}},
+		{"equality", "comparable structs and map keys", equalityLesson, []string{"==", "comparable", "map key", "struct equality", "Equal"}, []string{"structures", "maps"}, []string{"intermediate", "structs"}},
+		{"encapsulation", "package visibility", encapsulationLesson, []string{"exported", "unexported", "getter", "setter"}, []string{"structures"}, []string{"beginner", "structs", "packages"}},
+	}})
+}
+
+func structuresLesson() {
+
+	// structure definitions
+	trace()
+	type Employee struct {
+		EmployeeID int
+		FirstName  string
+		LastName   string
+	}
+
+	// structure literals
+	trace()
+	_ = Employee{1, "Alice", "Alisson"}
+	_ = Employee{FirstName: "Alice"}
+
+	// structure allocations
+	trace()
+	_ = new(Employee)
+	_ = &Employee{2, "Bob", "Bobson"}
+	_ = &Employee{FirstName: "Bob"}
+
+	// accessing fields
+	trace()
+	var employee Employee = Employee{FirstName: "A"}
+	fmt.Printf("employee first name: %v\n", employee.FirstName)
+	// Output:
+	// employee first name: A
+
+	// same notation with pointers
+	trace()
+	var employeePointer *Employee = &employee
+	fmt.Printf("employee first name: %v\n", employeePointer.FirstName)
+	// Output:
+	// employee first name: A
+
+	// structures are passed by value
+	// but are primarily used with pointers
+	trace()
+	type Team struct {
+		Manager   *Employee
+		Employees []*Employee
+	}
+
+	// anonymous structures
+	trace()
+	var point struct{ X, Y int }
+	point.X = 100
+
+	// anonymous structure literals
+	trace()
+	_ = struct{ X, Y, Z int }{X: 1, Y: 2, Z: 3}
+}
+
+type Animal struct {
+	LegsCount int
+}
+
+// methods are attached to a receiver type
+func (a *Animal) CanQuack() bool {
+	return false
+}
+
+// receivers are primarily pointers
+// to allow state mutations
+func (a *Animal) GrowLeg() {
+	a.LegsCount++
+}
+
+func (a *Animal) CountLegs() int {
+	return a.LegsCount
+}
+
+// value receivers get a copy
+// of the structure they are called on
+func (a Animal) Legs() int {
+	return a.LegsCount
+}
+
+// the method set of Animal holds the value methods
+// the method set of *Animal holds them all
+// only method sets count for satisfying an interface
+type LegGrower interface {
+	GrowLeg()
+}
+
+type LegCounter interface {
+	Legs() int
+}
+
+// the names of the methods in the method set of a type
+func methodNames(t reflect.Type) []string {
+	var names []string
+	for i := 0; i < t.NumMethod(); i++ {
+		names = append(names, t.Method(i).Name)
+	}
+	return names
+}
+
+// a method value evaluates its receiver once
+// when it is created, not when it is called
+// a pointer receiver binds the pointer
+// so later changes to the structure are seen
+// a value receiver binds a copy
+// so the structure is snapshotted
+func boundLegsCounts() (pointerBound, valueBound int) {
+	animal := &Animal{4}
+	countLegs := animal.CountLegs
+	legs := animal.Legs
+	animal.LegsCount = 6
+	return countLegs(), legs()
+}
+
+// structure embedding
+type Dog struct {
+	Animal
+	GoodBoyName string
+}
+
+// a method declared on the outer structure
+// shadows the promoted one with the same name
+func (d *Dog) CanQuack() bool {
+	return d.GoodBoyName == "Donald"
+}
+
+// promotion looks for a member
+// at the shallowest embedding depth first
+// Robot.LegsCount is at depth 1
+// and hides Dog.Animal.LegsCount at depth 2
+// two members found at the same depth
+// make the selector ambiguous and fail to compile
+type Robot struct {
+	LegsCount int
+}
+
+type RobotDog struct {
+	Dog
+	Robot
+}
+
+func methodsLesson() {
+
+	// methods
+	trace()
+	animal := &Animal{4}
+	fmt.Println(animal.CanQuack())
+	// Output:
+	// false
+
+	// converting from method to a function
+	// taking the receiver as first parameter
+	trace()
+	methodExpression := (*Animal).GrowLeg
+	methodExpression(animal)
+
+	// converting from method to a function
+	// with the receiver already bound
+	trace()
+	methodValue := animal.GrowLeg
+	methodValue()
+
+	// the bound receiver is the pointer
+	// for pointer methods and a copy
+	// for value methods
+	trace()
+	pointerBound, valueBound := boundLegsCounts()
+	fmt.Printf("pointer bound legs count: %v\n", pointerBound)
+	fmt.Printf("value bound legs count: %v\n", valueBound)
+	// Output:
+	// pointer bound legs count: 6
+	// value bound legs count: 4
+
+	// the method sets of a value and of a pointer
+	trace()
+	fmt.Printf("Animal: %v\n", methodNames(reflect.TypeOf(Animal{})))
+	fmt.Printf("*Animal: %v\n", methodNames(reflect.TypeOf(&Animal{})))
+	// Output:
+	// Animal: [Legs]
+	// *Animal: [CanQuack CountLegs GrowLeg Legs]
+
+	// an addressable value can still call pointer methods
+	// the compiler takes its address for us
+	// Animal{4}.GrowLeg() or animals["rex"].GrowLeg()
+	// are not addressable and do not compile
+	trace()
+	value := Animal{4}
+	value.GrowLeg()
+	fmt.Printf("value legs: %v\n", value.LegsCount)
+	// Output:
+	// value legs: 5
+
+	// an interface holds a copy of the value
+	// a pointer method would change the copy
+	// so a value never satisfies an interface
+	// that needs a pointer method
+	// var _ LegGrower = Animal{} does not compile
+	trace()
+	var boxed interface{} = value
+	_, valueGrows := boxed.(LegGrower)
+	_, valueCounts := boxed.(LegCounter)
+	fmt.Printf("value grows: %v, value counts: %v\n", valueGrows, valueCounts)
+	// Output:
+	// value grows: false, value counts: true
+
+	// a pointer satisfies both
+	// the value method is called on what it points to
+	trace()
+	boxed = &value
+	_, pointerGrows := boxed.(LegGrower)
+	_, pointerCounts := boxed.(LegCounter)
+	fmt.Printf("pointer grows: %v, pointer counts: %v\n", pointerGrows, pointerCounts)
+	// Output:
+	// pointer grows: true, pointer counts: true
+}
+
+func embeddingLesson() {
+
+	// the structure gains all
+	// the members of the embedded one
+	trace()
+	fido := &Dog{Animal{4}, "Fido"}
+	fmt.Printf("legs count: %v\n", fido.LegsCount)
+	fmt.Printf("good boy name: %v\n", fido.GoodBoyName)
+	// Output:
+	// legs count: 4
+	// good boy name: Fido
+
+	// including its attached methods
+	trace()
+	fido.GrowLeg()
+
+	// the embedded structure
+	// can be accessed explicitly
+	trace()
+	var _ *Animal = &fido.Animal
+
+	// methods of the outer structure
+	// shadow the embedded ones
+	// which stay reachable explicitly
+	trace()
+	fmt.Printf("dog can quack: %v\n", fido.CanQuack())
+	fmt.Printf("animal can quack: %v\n", fido.Animal.CanQuack())
+	// Output:
+	// dog can quack: false
+	// animal can quack: false
+
+	// the shallowest field wins
+	trace()
+	robotDog := &RobotDog{Dog{Animal{4}, "Rex"}, Robot{6}}
+	fmt.Printf("robot dog legs count: %v\n", robotDog.LegsCount)
+	fmt.Printf("robot dog animal legs count: %v\n", robotDog.Animal.LegsCount)
+	// Output:
+	// robot dog legs count: 6
+	// robot dog animal legs count: 4
+}
+
+// encapsulation
+// members starting with a lower cased letter
+// are only visible inside their package
+type Cake struct {
+	hugeCaloriesCount int
+}
+
+// getters and setters
+func (cake *Cake) HugeCaloriesCount() int {
+	return cake.hugeCaloriesCount
+}
+func (cake *Cake) SetHugeCaloriesCount(value int) {
+	cake.hugeCaloriesCount = value
+}
+
+func encapsulationLesson() {
+
+	// visible inside this package
+	// the visibility lesson tries from another one
+	trace()
+	var hugeCake = &Cake{100000}
+	_ = hugeCake.hugeCaloriesCount
+}
+
+// struct tags are strings attached to fields
+// by convention key:"value" pairs separated by spaces
+// the compiler ignores them
+// packages read them with reflection
+type SignUp struct {
+	Email    string `json:"email" validate:"required"`
+	Age      int    `json:"age,omitempty" validate:"min=13"`
+	Password string `json:"-" validate:"required"`
+	Referrer string `json:"referrer,omitempty"`
+}
+
+// a tiny validator driven by the validate tags
+// every failing field becomes a ValidationError
+func validateFields(value interface{}) error {
+	structValue := reflect.ValueOf(value)
+	structType := structValue.Type()
+
+	var errs []error
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		rule, ok := field.Tag.Lookup("validate")
+		if !ok {
+			continue
+		}
+		fieldValue := structValue.Field(i)
+		switch {
+		case rule == "required" && fieldValue.IsZero():
+			errs = append(errs, &ValidationError{field.Name, fieldValue.Interface(), "is required"})
+		case strings.HasPrefix(rule, "min="):
+			minimum, _ := strconv.Atoi(strings.TrimPrefix(rule, "min="))
+			if fieldValue.Int() < int64(minimum) {
+				errs = append(errs, &ValidationError{field.Name, fieldValue.Interface(), fmt.Sprintf("must be at least %v", minimum)})
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func tagsLesson() {
+
+	// reading the tags of a field
+	// Get returns an empty string for a missing key
+	// Lookup tells it apart from an empty value
+	trace()
+	field, _ := reflect.TypeOf(SignUp{}).FieldByName("Age")
+	fmt.Printf("tag: %v\n", field.Tag)
+	fmt.Printf("json: %q, validate: %q\n", field.Tag.Get("json"), field.Tag.Get("validate"))
+	_, ok := field.Tag.Lookup("xml")
+	fmt.Printf("has xml: %v\n", ok)
+	// Output:
+	// tag: json:"age,omitempty" validate:"min=13"
+	// json: "age,omitempty", validate: "min=13"
+	// has xml: false
+
+	// encoding/json renames fields with its tag
+	// omitempty leaves out zero values
+	// - leaves a field out entirely
+	trace()
+	signUp := SignUp{Email: "alice@example.com", Password: "secret"}
+	encoded, _ := json.Marshal(signUp)
+	fmt.Println(string(encoded))
+	// Output:
+	// {"email":"alice@example.com"}
+
+	// the same tags can drive our own code
+	trace()
+	fmt.Println(validateFields(signUp))
+	fmt.Println(validateFields(SignUp{Age: 30}))
+	// Output:
+	// invalid Age 0: must be at least 13
+	// invalid Email : is required
+	// invalid Password : is required
+}
+
+// every field is comparable
+// so Point supports == and can be a map key
+type Point struct {
+	X, Y int
+}
+
+// a slice field makes the struct not comparable
+// a == b fails to compile with
+// invalid operation: a == b (struct containing []string cannot be compared)
+// and map[Order]int with
+// invalid map key type Order
+type Order struct {
+	ID    int
+	Items []string
+}
+
+// comparing has to be written by hand
+func (o Order) Equal(other Order) bool {
+	return o.ID == other.ID && slices.Equal(o.Items, other.Items)
+}
+
+func equalityLesson() {
+
+	// == compares the fields one by one
+	trace()
+	fmt.Printf("equal: %v, different: %v\n", Point{1, 2} == Point{1, 2}, Point{1, 2} == Point{2, 1})
+	// Output:
+	// equal: true, different: false
+
+	// pointers compare addresses
+	// not what they point to
+	trace()
+	first, second := &Point{1, 2}, &Point{1, 2}
+	fmt.Printf("same pointer: %v, same point: %v\n", first == second, *first == *second)
+	// Output:
+	// same pointer: false, same point: true
+
+	// comparable structs make map keys
+	// a literal finds the entry back
+	trace()
+	landmarks := map[Point]string{{0, 0}: "origin", {3, 4}: "treasure"}
+	fmt.Printf("at 3,4: %v\n", landmarks[Point{3, 4}])
+	// Output:
+	// at 3,4: treasure
+
+	// arrays are comparable too
+	// unlike slices
+	trace()
+	visited := map[[2]int]bool{{1, 1}: true}
+	fmt.Printf("visited 1,1: %v\n", visited[[2]int{1, 1}])
+	// Output:
+	// visited 1,1: true
+
+	// structs with slices, maps or functions
+	// need their own comparison
+	trace()
+	order := Order{1, []string{"cookie"}}
+	fmt.Printf("equal orders: %v\n", order.Equal(Order{1, []string{"cookie"}}))
+	// Output:
+	// equal orders: true
+
+	// interface fields compile
+	// but panic when their values cannot be compared
+	trace()
+	type Labeled struct {
+		Value interface{}
+	}
+	fmt.Println(recovered(func() {
+		_ = Labeled{[]int{1}} == Labeled{[]int{1}}
+	}))
+	// Output:
+	// runtime error: comparing uncomparable type []int
+}
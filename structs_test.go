@@ -0,0 +1,87 @@
+package main
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestMethodValuesBindTheirReceiver(t *testing.T) {
+	pointerBound, valueBound := boundLegsCounts()
+	if pointerBound != 6 {
+		t.Errorf("pointer bound legs count = %v, want 6", pointerBound)
+	}
+	if valueBound != 4 {
+		t.Errorf("value bound legs count = %v, want 4", valueBound)
+	}
+}
+
+func TestEmbeddedMembersShadowing(t *testing.T) {
+	donald := &Dog{Animal{4}, "Donald"}
+	if !donald.CanQuack() {
+		t.Error("Dog.CanQuack was not called")
+	}
+	if donald.Animal.CanQuack() {
+		t.Error("Animal.CanQuack was not called")
+	}
+
+	robotDog := &RobotDog{Dog{Animal{4}, "Rex"}, Robot{6}}
+	if robotDog.LegsCount != 6 {
+		t.Errorf("robotDog.LegsCount = %v, want the Robot one 6", robotDog.LegsCount)
+	}
+	if robotDog.Animal.LegsCount != 4 {
+		t.Errorf("robotDog.Animal.LegsCount = %v, want 4", robotDog.Animal.LegsCount)
+	}
+}
+
+func TestValidateFields(t *testing.T) {
+	tests := []struct {
+		signUp SignUp
+		fields []string
+	}{
+		{SignUp{"alice@example.com", 30, "secret", ""}, nil},
+		{SignUp{"alice@example.com", 12, "secret", ""}, []string{"Age"}},
+		{SignUp{"", 30, "", "bob"}, []string{"Email", "Password"}},
+	}
+	for _, test := range tests {
+		err := validateFields(test.signUp)
+		var fields []string
+		if err != nil {
+			for _, member := range err.(interface{ Unwrap() []error }).Unwrap() {
+				var validationError *ValidationError
+				if errors.As(member, &validationError) {
+					fields = append(fields, validationError.Field)
+				}
+			}
+		}
+		if !reflect.DeepEqual(fields, test.fields) {
+			t.Errorf("validateFields(%+v) failed on %v, want %v", test.signUp, fields, test.fields)
+		}
+	}
+}
+
+func TestMethodNames(t *testing.T) {
+	if got, want := methodNames(reflect.TypeOf(Animal{})), []string{"Legs"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("methodNames(Animal) = %v, want %v", got, want)
+	}
+	if got, want := methodNames(reflect.TypeOf(&Animal{})), []string{"CanQuack", "CountLegs", "GrowLeg", "Legs"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("methodNames(*Animal) = %v, want %v", got, want)
+	}
+}
+
+func TestOrderEqual(t *testing.T) {
+	tests := []struct {
+		a, b Order
+		want bool
+	}{
+		{Order{1, []string{"a", "b"}}, Order{1, []string{"a", "b"}}, true},
+		{Order{1, nil}, Order{1, []string{}}, true},
+		{Order{1, []string{"a"}}, Order{2, []string{"a"}}, false},
+		{Order{1, []string{"a", "b"}}, Order{1, []string{"b", "a"}}, false},
+	}
+	for _, test := range tests {
+		if got := test.a.Equal(test.b); got != test.want {
+			t.Errorf("%v.Equal(%v) = %v, want %v", test.a, test.b, got, test.want)
+		}
+	}
+}
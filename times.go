@@ -0,0 +1,255 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+func init() {
+	RegisterChapter(Chapter{11, "Time", []Lesson{
+		{"dates", "formatting and parsing times and durations", datesLesson, []string{"time", "time.Format", "time.Parse", "layout", "reference time", "RFC3339", "time.ParseDuration", "Duration", "YYYY"}, []string{"formatting"}, []string{"beginner", "time"}},
+		{"timers", "timers, tickers and time.After", timersLesson, []string{"time.NewTimer", "time.NewTicker", "time.After", "Stop", "Reset", "timeout", "select"}, []string{"select"}, []string{"intermediate", "time", "concurrency"}},
+		{"clocks", "the monotonic clock and measuring durations", clocksLesson, []string{"time.Now", "time.Since", "Sub", "monotonic clock", "wall clock", "Round(0)", "Equal", "benchmark"}, []string{"dates"}, []string{"intermediate", "time"}},
+	}})
+}
+
+// layouts tried in order
+// until one of them parses the value
+var whenLayouts = []string{
+	time.RFC3339,
+	"2006-01-02 15:04",
+	time.DateOnly,
+}
+
+func parseWhen(value string) (time.Time, error) {
+	var err error
+	for _, layout := range whenLayouts {
+		var when time.Time
+		when, err = time.Parse(layout, value)
+		if err == nil {
+			return when, nil
+		}
+	}
+	return time.Time{}, err
+}
+
+// time.After in a loop creates a new timer
+// on every iteration
+// one timer reset after each message does the same job
+func collectUntilIdle(messages <-chan string, idle time.Duration) []string {
+	var collected []string
+	timer := time.NewTimer(idle)
+	defer timer.Stop()
+	for {
+		select {
+		case message, ok := <-messages:
+			if !ok {
+				return collected
+			}
+			collected = append(collected, message)
+			timer.Reset(idle)
+		case <-timer.C:
+			return collected
+		}
+	}
+}
+
+// time.Since reads the monotonic clock
+// so the duration stays right
+// even when the wall clock is changed meanwhile
+// the -time runner mode measures every lesson with it
+func measure(run func() error) (time.Duration, error) {
+	start := time.Now()
+	err := run()
+	return time.Since(start), err
+}
+
+func datesLesson() {
+
+	// layouts are written with the reference time
+	// Mon Jan 2 15:04:05 MST 2006
+	// that is 01/02 03:04:05PM '06 -0700
+	trace()
+	launch := time.Date(2024, time.March, 9, 14, 5, 30, 0, time.UTC)
+	fmt.Println(launch.Format("2006-01-02 15:04:05"))
+	fmt.Println(launch.Format("Jan 2, 2006 at 3:04pm"))
+	fmt.Println(launch.Format(time.RFC3339))
+	// Output:
+	// 2024-03-09 14:05:30
+	// Mar 9, 2024 at 2:05pm
+	// 2024-03-09T14:05:30Z
+
+	// letters that are not part of the reference time
+	// are copied as they are
+	// 03 is the hour on a 12 hour clock without PM
+	// go vet reports the swapped month and day of 2006-02-01
+	trace()
+	fmt.Println(launch.Format("YYYY-MM-DD hh:mm"))
+	fmt.Println(launch.Format("2006-01-02 03:04"))
+	// Output:
+	// YYYY-MM-DD hh:mm
+	// 2024-03-09 02:05
+
+	// parsing uses the same layouts
+	// times without a zone are in UTC
+	trace()
+	day, err := time.Parse(time.DateOnly, "2024-03-09")
+	fmt.Printf("day: %v, err: %v\n", day, err)
+	_, err = time.Parse(time.DateOnly, "09/03/2024")
+	fmt.Printf("err: %v\n", err)
+	// Output:
+	// day: 2024-03-09 00:00:00 +0000 UTC, err: <nil>
+	// err: parsing time "09/03/2024" as "2006-01-02": cannot parse "09/03/2024" as "2006"
+
+	// RFC3339 carries its own offset
+	// ParseInLocation applies one when the value has none
+	trace()
+	offset, _ := time.Parse(time.RFC3339, "2024-03-09T14:05:30-05:00")
+	fmt.Printf("offset: %v, utc: %v\n", offset, offset.UTC())
+	montreal := time.FixedZone("EST", -5*60*60)
+	local, _ := time.ParseInLocation("2006-01-02 15:04", "2024-03-09 14:05", montreal)
+	fmt.Printf("local: %v\n", local)
+	// Output:
+	// offset: 2024-03-09 14:05:30 -0500 -0500, utc: 2024-03-09 19:05:30 +0000 UTC
+	// local: 2024-03-09 14:05:00 -0500 EST
+
+	// trying a few layouts in turn
+	trace()
+	for _, value := range []string{"2024-03-09T14:05:30Z", "2024-03-09 14:05", "tomorrow"} {
+		when, err := parseWhen(value)
+		fmt.Printf("%v: %v %v\n", value, when, err)
+	}
+	// Output:
+	// 2024-03-09T14:05:30Z: 2024-03-09 14:05:30 +0000 UTC <nil>
+	// 2024-03-09 14:05: 2024-03-09 14:05:00 +0000 UTC <nil>
+	// tomorrow: 0001-01-01 00:00:00 +0000 UTC parsing time "tomorrow" as "2006-01-02": cannot parse "tomorrow" as "2006"
+
+	// durations parse from strings like 1h30m
+	// there is no unit for days
+	trace()
+	meeting, _ := time.ParseDuration("1h30m")
+	fmt.Printf("meeting: %v, minutes: %v\n", meeting, meeting.Minutes())
+	_, err = time.ParseDuration("1d")
+	fmt.Printf("err: %v\n", err)
+	// Output:
+	// meeting: 1h30m0s, minutes: 90
+	// err: time: unknown unit "d" in duration "1d"
+
+	// a duration is a number of nanoseconds
+	// so time.Sleep(5) sleeps 5 nanoseconds
+	// multiply by a unit instead
+	trace()
+	seconds := 5
+	fmt.Printf("%v %v %v\n", time.Duration(seconds), time.Duration(seconds)*time.Second, launch.Add(36*time.Hour).Format(time.DateOnly))
+	// Output:
+	// 5ns 5s 2024-03-11
+}
+
+func timersLesson() {
+
+	// a timer sends the time on its channel once
+	trace()
+	timer := time.NewTimer(10 * time.Millisecond)
+	<-timer.C
+	fmt.Println("timer fired")
+	// Output:
+	// timer fired
+
+	// Stop reports whether it stopped the timer
+	// before it fired
+	trace()
+	timer = time.NewTimer(time.Hour)
+	fmt.Printf("stopped: %v\n", timer.Stop())
+	fmt.Printf("stopped again: %v\n", timer.Stop())
+	// Output:
+	// stopped: true
+	// stopped again: false
+
+	// a ticker sends the time repeatedly
+	// it must be stopped to release it
+	trace()
+	ticker := time.NewTicker(10 * time.Millisecond)
+	defer ticker.Stop()
+	for tick := range 3 {
+		<-ticker.C
+		fmt.Printf("tick %v\n", tick+1)
+	}
+	// Output:
+	// tick 1
+	// tick 2
+	// tick 3
+
+	// time.After in a select gives up waiting
+	// the buffer lets the late sender finish
+	trace()
+	result := make(chan string, 1)
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		result <- "done"
+	}()
+	select {
+	case value := <-result:
+		fmt.Println(value)
+	case <-time.After(20 * time.Millisecond):
+		fmt.Println("timed out")
+	}
+	// Output:
+	// timed out
+
+	// every time.After in a loop allocates a timer
+	// before Go 1.23 none was released until it fired
+	// a single timer reset on each message avoids both
+	trace()
+	messages := make(chan string)
+	go func() {
+		messages <- "first"
+		messages <- "second"
+	}()
+	fmt.Printf("collected: %v\n", collectUntilIdle(messages, 50*time.Millisecond))
+	// Output:
+	// collected: [first second]
+}
+
+func clocksLesson() {
+
+	// time.Now reads both the wall clock
+	// and a monotonic clock that only moves forward
+	// the monotonic reading prints as m=
+	// Round(0) strips it
+	trace()
+	now := time.Now()
+	fmt.Printf("monotonic: %v, stripped: %v\n", strings.Contains(now.String(), "m="), strings.Contains(now.Round(0).String(), "m="))
+	// Output:
+	// monotonic: true, stripped: false
+
+	// == compares the monotonic readings too
+	// Equal compares only the instants
+	trace()
+	fmt.Printf("==: %v, Equal: %v\n", now == now.Round(0), now.Equal(now.Round(0)))
+	// Output:
+	// ==: false, Equal: true
+
+	// the wall clock can be set back
+	// by hand or by time synchronization
+	// subtracting wall readings then goes backwards
+	// the monotonic readings are not affected
+	// here the later wall reading is set back an hour
+	trace()
+	later := now.Add(20 * time.Millisecond)
+	setBack := later.Round(0).Add(-time.Hour)
+	fmt.Printf("monotonic: %v, wall: %v\n", later.Sub(now), setBack.Sub(now.Round(0)))
+	// Output:
+	// monotonic: 20ms, wall: -59m59.98s
+
+	// measuring a piece of work
+	trace()
+	elapsed, err := measure(func() error {
+		time.Sleep(20 * time.Millisecond)
+		return errors.New("burnt")
+	})
+	fmt.Printf("at least 20ms: %v, err: %v\n", elapsed >= 20*time.Millisecond, err)
+	// Output:
+	// at least 20ms: true, err: burnt
+}
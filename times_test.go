@@ -0,0 +1,56 @@
+package main
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseWhen(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    time.Time
+		wantErr bool
+	}{
+		{"2024-03-09T14:05:30-05:00", time.Date(2024, time.March, 9, 19, 5, 30, 0, time.UTC), false},
+		{"2024-03-09 14:05", time.Date(2024, time.March, 9, 14, 5, 0, 0, time.UTC), false},
+		{"2024-03-09", time.Date(2024, time.March, 9, 0, 0, 0, 0, time.UTC), false},
+		{"03/09/2024", time.Time{}, true},
+	}
+	for _, test := range tests {
+		got, err := parseWhen(test.value)
+		if (err != nil) != test.wantErr {
+			t.Errorf("parseWhen(%q) error = %v, wantErr %v", test.value, err, test.wantErr)
+		}
+		if !got.Equal(test.want) {
+			t.Errorf("parseWhen(%q) = %v, want %v", test.value, got, test.want)
+		}
+	}
+}
+
+func TestCollectUntilIdle(t *testing.T) {
+	closed := make(chan string, 2)
+	closed <- "a"
+	closed <- "b"
+	close(closed)
+	if got := collectUntilIdle(closed, time.Hour); len(got) != 2 {
+		t.Errorf("collectUntilIdle(closed) = %v, want [a b]", got)
+	}
+
+	idle := make(chan string, 1)
+	idle <- "a"
+	if got := collectUntilIdle(idle, 10*time.Millisecond); len(got) != 1 {
+		t.Errorf("collectUntilIdle(idle) = %v, want [a]", got)
+	}
+}
+
+func TestMeasure(t *testing.T) {
+	burnt := errors.New("burnt")
+	elapsed, err := measure(func() error {
+		time.Sleep(10 * time.Millisecond)
+		return burnt
+	})
+	if elapsed < 10*time.Millisecond || err != burnt {
+		t.Errorf("measure() = %v, %v, want at least 10ms and %v", elapsed, err, burnt)
+	}
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"time"
+)
+
+// how long a lesson took to run
+// and why it did not finish
+type lessonTiming struct {
+	Name     string
+	Duration time.Duration
+	Err      error
+}
+
+// lessons slower than this are highlighted
+// most of them are concurrency demos
+// waiting on timers for their goroutines
+const slowLesson = 500 * time.Millisecond
+
+func timeLesson(lesson Lesson, timeout time.Duration) lessonTiming {
+	duration, err := measure(func() error {
+		return runIsolated(lesson, timeout)
+	})
+	return lessonTiming{lesson.Name, duration, err}
+}
+
+func printTimings(w io.Writer, timings []lessonTiming) {
+	var total time.Duration
+	fmt.Fprintf(w, "\n%-15v %12v\n", "lesson", "duration")
+	for _, timing := range timings {
+		total += timing.Duration
+		slow := ""
+		if timing.Err != nil {
+			slow = "  failed"
+		} else if timing.Duration >= slowLesson {
+			slow = "  slow"
+		}
+		fmt.Fprintf(w, "%-15v %12v%v\n", timing.Name, timing.Duration.Round(time.Microsecond), slow)
+	}
+	fmt.Fprintf(w, "%-15v %12v\n", "total", total.Round(time.Microsecond))
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestTimeLesson(t *testing.T) {
+	timing := timeLesson(Lesson{"nap", "", func() { time.Sleep(10 * time.Millisecond) }, nil, nil, nil}, time.Second)
+	if timing.Name != "nap" || timing.Duration < 10*time.Millisecond || timing.Err != nil {
+		t.Errorf("timeLesson() = %v, want nap taking at least 10ms", timing)
+	}
+}
+
+func TestPrintTimings(t *testing.T) {
+	var buffer bytes.Buffer
+	printTimings(&buffer, []lessonTiming{
+		{"variables", 15 * time.Microsecond, nil},
+		{"channels", 5 * time.Second, nil},
+		{"select", time.Second, errors.New("timed out after 1s")},
+	})
+
+	want := `
+lesson              duration
+variables               15µs
+channels                  5s  slow
+select                    1s  failed
+total              6.000015s
+`
+	if got := buffer.String(); got != want {
+		t.Errorf("printTimings printed\n%v\nwant\n%v", got, want)
+	}
+}
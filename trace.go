@@ -0,0 +1,24 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+)
+
+// marking where each block of a lesson starts
+// go run . -trace
+// so what gets printed can be traced back
+// to the lines that printed it
+var tracing = false
+
+func trace() {
+	if !tracing {
+		return
+	}
+	_, file, line, ok := runtime.Caller(1)
+	if !ok {
+		return
+	}
+	fmt.Printf("%v%v:%v ▶%v\n", paint(detailColor), filepath.Base(file), line, paint(outputColor))
+}
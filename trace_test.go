@@ -0,0 +1,25 @@
+package main
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestTrace(t *testing.T) {
+	tracingReal := tracing
+	defer func() { tracing = tracingReal }()
+
+	tracing = false
+	if output, _ := captureOutput(trace); output != "" {
+		t.Errorf("trace() printed %q without -trace", output)
+	}
+
+	tracing = true
+	output, err := captureOutput(func() { trace() })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !regexp.MustCompile(`^trace_test\.go:[0-9]+ ▶\n$`).MatchString(output) {
+		t.Errorf("trace() printed %q, want trace_test.go:line ▶", output)
+	}
+}
@@ -0,0 +1,263 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"unicode/utf8"
+)
+
+// browsing the lessons interactively
+// go run . -tui
+// the terminal is switched to raw mode with stty
+// so keys are read as soon as they are pressed
+
+type key int
+
+const (
+	keyOther key = iota
+	keyUp
+	keyDown
+	keyLeft
+	keyRight
+	keyEnter
+	keyEscape
+	keyQuit
+)
+
+func stty(args ...string) (string, error) {
+	command := exec.Command("stty", args...)
+	command.Stdin = os.Stdin
+	output, err := command.Output()
+	return strings.TrimSpace(string(output)), err
+}
+
+type terminal struct {
+	state   string
+	rows    int
+	columns int
+}
+
+func openTerminal() (*terminal, error) {
+	state, err := stty("-g")
+	if err != nil {
+		return nil, fmt.Errorf("while saving the terminal state: %w", err)
+	}
+	terminal := &terminal{state: state, rows: 24, columns: 80}
+	if size, err := stty("size"); err == nil {
+		fmt.Sscan(size, &terminal.rows, &terminal.columns)
+	}
+	if _, err := stty("raw", "-echo"); err != nil {
+		return nil, fmt.Errorf("while switching the terminal to raw mode: %w", err)
+	}
+	fmt.Print("\x1b[?25l")
+	return terminal, nil
+}
+
+func (t *terminal) close() {
+	stty(t.state)
+	fmt.Print("\x1b[?25h\x1b[H\x1b[2J")
+}
+
+// arrow keys arrive as escape sequences
+func (t *terminal) readKey() (key, error) {
+	buffer := make([]byte, 8)
+	n, err := os.Stdin.Read(buffer)
+	if err != nil {
+		return keyOther, err
+	}
+	switch input := string(buffer[:n]); input {
+	case "\x1b[A", "k":
+		return keyUp, nil
+	case "\x1b[B", "j":
+		return keyDown, nil
+	case "\x1b[D", "h":
+		return keyLeft, nil
+	case "\x1b[C", "l":
+		return keyRight, nil
+	case "\r", "\n":
+		return keyEnter, nil
+	case "\x1b", "\x7f":
+		return keyEscape, nil
+	case "q", "\x03":
+		return keyQuit, nil
+	}
+	return keyOther, nil
+}
+
+// raw mode does not return the carriage
+// on new lines so each line is positioned
+func (t *terminal) draw(lines []string) {
+	var screen strings.Builder
+	screen.WriteString("\x1b[H\x1b[2J")
+	for i, line := range lines {
+		if i >= t.rows {
+			break
+		}
+		fmt.Fprintf(&screen, "\x1b[%d;1H%s", i+1, line)
+	}
+	fmt.Print(screen.String())
+}
+
+// expanding tabs then padding or truncating
+// to exactly width runes
+func fit(line string, width int) string {
+	line = strings.ReplaceAll(line, "\t", "    ")
+	count := utf8.RuneCountInString(line)
+	if count > width {
+		return string([]rune(line)[:width])
+	}
+	return line + strings.Repeat(" ", width-count)
+}
+
+type browser struct {
+	terminal *terminal
+	lessons  []Lesson
+	selected int
+
+	// the lesson being viewed
+	viewing  bool
+	source   []string
+	sections []int
+	section  int
+	output   []string
+	outputs  map[string]string
+}
+
+func browse(lessons []Lesson) error {
+	terminal, err := openTerminal()
+	if err != nil {
+		return err
+	}
+	defer terminal.close()
+
+	browser := &browser{terminal: terminal, lessons: lessons, outputs: make(map[string]string)}
+	for {
+		browser.draw()
+		pressed, err := terminal.readKey()
+		if err != nil {
+			return err
+		}
+		if pressed == keyQuit {
+			return nil
+		}
+		browser.handle(pressed)
+	}
+}
+
+func (b *browser) handle(pressed key) {
+	if !b.viewing {
+		switch pressed {
+		case keyUp:
+			b.selected = (b.selected + len(b.lessons) - 1) % len(b.lessons)
+		case keyDown:
+			b.selected = (b.selected + 1) % len(b.lessons)
+		case keyEnter, keyRight:
+			b.open()
+		}
+		return
+	}
+
+	switch pressed {
+	case keyLeft:
+		if b.section > 0 {
+			b.section--
+		}
+	case keyRight:
+		if b.section < len(b.sections)-1 {
+			b.section++
+		}
+	case keyEscape:
+		b.viewing = false
+	}
+}
+
+// outputs are captured once
+// the concurrency lessons take a few seconds
+func (b *browser) open() {
+	lesson := b.lessons[b.selected]
+	b.terminal.draw([]string{fmt.Sprintf("running %v...", lesson.Name)})
+
+	code, err := lessonSource(lesson)
+	if err != nil {
+		code.Source = err.Error()
+	}
+	b.source = strings.Split(code.Source, "\n")
+	b.sections = sectionStarts(b.source)
+	b.section = 0
+
+	output, ok := b.outputs[lesson.Name]
+	if !ok {
+		output, err = captureOutput(lesson.Run)
+		if err != nil {
+			output = err.Error()
+		}
+		b.outputs[lesson.Name] = output
+	}
+	b.output = strings.Split(output, "\n")
+	b.viewing = true
+}
+
+func (b *browser) draw() {
+	if !b.viewing {
+		b.drawMenu()
+		return
+	}
+	b.drawLesson()
+}
+
+func (b *browser) drawMenu() {
+	lines := []string{"\x1b[1mlessons\x1b[0m  ↑↓ select  enter open  q quit", ""}
+	for i, lesson := range b.lessons {
+		line := fmt.Sprintf("  %-15v %v", lesson.Name, lesson.Description)
+		if i == b.selected {
+			line = "\x1b[7m" + fit(line, b.terminal.columns) + "\x1b[0m"
+		}
+		lines = append(lines, line)
+	}
+	b.terminal.draw(lines)
+}
+
+// the source on the left and the output on the right
+// the current section is highlighted
+func (b *browser) drawLesson() {
+	lesson := b.lessons[b.selected]
+	lines := []string{
+		fmt.Sprintf("\x1b[1m%v\x1b[0m %v  ←→ sections %v/%v  esc back  q quit",
+			lesson.Name, lesson.Description, b.section+1, len(b.sections)),
+		"",
+	}
+
+	height := b.terminal.rows - len(lines)
+	width := (b.terminal.columns - 3) / 2
+	start := b.sections[b.section]
+	end := len(b.source)
+	if b.section+1 < len(b.sections) {
+		end = b.sections[b.section+1]
+	}
+
+	// scrolling the current section into view
+	offset := 0
+	if end > height {
+		offset = min(start, len(b.source)-height)
+	}
+
+	for row := 0; row < height; row++ {
+		left := ""
+		if i := offset + row; i < len(b.source) {
+			left = fit(b.source[i], width)
+			if start <= i && i < end {
+				left = "\x1b[7m" + left + "\x1b[0m"
+			}
+		} else {
+			left = fit("", width)
+		}
+		right := ""
+		if row < len(b.output) {
+			right = fit(b.output[row], width)
+		}
+		lines = append(lines, left+" │ "+right)
+	}
+	b.terminal.draw(lines)
+}
@@ -0,0 +1,21 @@
+package main
+
+import "testing"
+
+func TestFit(t *testing.T) {
+	var tests = []struct {
+		line  string
+		width int
+		want  string
+	}{
+		{"abc", 5, "abc  "},
+		{"abcdef", 3, "abc"},
+		{"\tx", 6, "    x "},
+		{"γλώσσα", 3, "γλώ"},
+	}
+	for _, test := range tests {
+		if got := fit(test.line, test.width); got != test.want {
+			t.Errorf("fit(%q, %v) = %q, want %q", test.line, test.width, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"unsafe"
+)
+
+// fields are aligned on their own size
+// the compiler pads between them
+// ordering them from largest to smallest
+// leaves less padding
+type paddedOrder struct {
+	Shipped bool
+	ID      int64
+	Paid    bool
+}
+
+type packedOrder struct {
+	ID      int64
+	Shipped bool
+	Paid    bool
+}
+
+// unsafe.Pointer converts between any pointer types
+// nothing checks the memory fits the new type
+// the result can break with any new Go version
+// or on another architecture
+// prefer math.Float64bits and the like when they exist
+func float64Bits(f float64) uint64 {
+	return *(*uint64)(unsafe.Pointer(&f))
+}
+
+// uintptr holds an address as a plain integer
+// converting it back to unsafe.Pointer
+// must happen in the same expression
+// so the order is never left unreferenced
+func paidField(order *paddedOrder) *bool {
+	return (*bool)(unsafe.Pointer(uintptr(unsafe.Pointer(order)) + unsafe.Offsetof(order.Paid)))
+}
+
+// unsafe.Add does the same arithmetic
+// without going through uintptr at all
+func paidFieldAdd(order *paddedOrder) *bool {
+	return (*bool)(unsafe.Add(unsafe.Pointer(order), unsafe.Offsetof(order.Paid)))
+}
+
+func unsafeLesson() {
+
+	// the size of a value in bytes
+	// the sizes are those of a 64 bits platform
+	trace()
+	fmt.Printf("bool: %v, int32: %v, int: %v, string: %v, slice: %v\n",
+		unsafe.Sizeof(true), unsafe.Sizeof(int32(0)), unsafe.Sizeof(0), unsafe.Sizeof(""), unsafe.Sizeof([]int{}))
+	// Output:
+	// bool: 1, int32: 4, int: 8, string: 16, slice: 24
+
+	// padding between fields
+	trace()
+	fmt.Printf("padded: %v bytes, packed: %v bytes\n", unsafe.Sizeof(paddedOrder{}), unsafe.Sizeof(packedOrder{}))
+	// Output:
+	// padded: 24 bytes, packed: 16 bytes
+
+	// where each field starts
+	// and how fields must be aligned
+	trace()
+	padded := paddedOrder{}
+	fmt.Printf("offsets: %v %v %v\n", unsafe.Offsetof(padded.Shipped), unsafe.Offsetof(padded.ID), unsafe.Offsetof(padded.Paid))
+	fmt.Printf("aligns: %v %v\n", unsafe.Alignof(padded.Shipped), unsafe.Alignof(padded.ID))
+	// Output:
+	// offsets: 0 8 16
+	// aligns: 1 8
+
+	// reading the bits of a float as an integer
+	// dangerous, only shown to recognize it
+	trace()
+	fmt.Printf("unsafe: %x\n", float64Bits(1.5))
+	fmt.Printf("math:   %x\n", math.Float64bits(1.5))
+	// Output:
+	// unsafe: 3ff8000000000000
+	// math:   3ff8000000000000
+
+	// a string viewed as bytes without a copy
+	// the bytes must never be modified
+	// strings are immutable and may live in read only memory
+	trace()
+	greeting := "hello"
+	bytes := unsafe.Slice(unsafe.StringData(greeting), len(greeting))
+	fmt.Printf("bytes: %v\n", bytes)
+	// Output:
+	// bytes: [104 101 108 108 111]
+}
+
+func uintptrLesson() {
+
+	// a uintptr is an integer the size of a pointer
+	// the garbage collector does not see it as a reference
+	trace()
+	order := paddedOrder{ID: 1}
+	fmt.Printf("uintptr: %v bytes, pointer: %v bytes\n", unsafe.Sizeof(uintptr(0)), unsafe.Sizeof(&order))
+	// Output:
+	// uintptr: 8 bytes, pointer: 8 bytes
+
+	// pointer arithmetic in a single expression
+	trace()
+	*paidField(&order) = true
+	fmt.Printf("paid: %v\n", order.Paid)
+	// Output:
+	// paid: true
+
+	// storing the uintptr in a variable is a bug
+	//   address := uintptr(unsafe.Pointer(&order))
+	//   paid := (*bool)(unsafe.Pointer(address + 16))
+	// nothing keeps the order alive in between
+	// the garbage collector may free it
+	// or a growing stack may move it elsewhere
+	// go vet reports a possible misuse of unsafe.Pointer
+	trace()
+	*paidFieldAdd(&order) = false
+	fmt.Printf("paid: %v\n", order.Paid)
+	// Output:
+	// paid: false
+
+	// stepping through the elements of an array
+	trace()
+	numbers := [4]int{10, 20, 30, 40}
+	third := *(*int)(unsafe.Add(unsafe.Pointer(&numbers[0]), 2*unsafe.Sizeof(numbers[0])))
+	fmt.Printf("third: %v\n", third)
+	// Output:
+	// third: 30
+
+	// checkptr verifies pointer conversions at run time
+	// it panics when a pointer lands outside its allocation
+	// or is converted to a type that does not fit
+	// go run -gcflags=all=-d=checkptr .
+	// -race and -msan turn it on automatically
+	// this view of the ID stays within the order
+	// the byte order is that of a little endian platform
+	trace()
+	idBytes := unsafe.Slice((*byte)(unsafe.Pointer(&order.ID)), unsafe.Sizeof(order.ID))
+	fmt.Printf("id bytes: %v\n", idBytes)
+	// Output:
+	// id bytes: [1 0 0 0 0 0 0 0]
+}
@@ -0,0 +1,24 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFloat64Bits(t *testing.T) {
+	for _, f := range []float64{0, 1.5, -2.25, math.Inf(1), math.MaxFloat64} {
+		if got, want := float64Bits(f), math.Float64bits(f); got != want {
+			t.Errorf("float64Bits(%v) = %x, want %x", f, got, want)
+		}
+	}
+}
+
+func TestPaidField(t *testing.T) {
+	order := paddedOrder{}
+	if got := paidField(&order); got != &order.Paid {
+		t.Errorf("paidField(&order) = %p, want %p", got, &order.Paid)
+	}
+	if got := paidFieldAdd(&order); got != &order.Paid {
+		t.Errorf("paidFieldAdd(&order) = %p, want %p", got, &order.Paid)
+	}
+}
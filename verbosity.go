@@ -0,0 +1,40 @@
+package main
+
+import "fmt"
+
+// how much the lessons say
+// go run . -quiet
+// go run . -v
+type verbosity int
+
+const (
+	// headline results only
+	quiet verbosity = iota
+
+	// results and the narration
+	// explaining what is going on
+	normal
+
+	// everything including extra details
+	verbose
+)
+
+var level = normal
+
+// narration is suppressed by -quiet
+func narrate(format string, args ...interface{}) {
+	if level >= normal {
+		fmt.Print(paint(narrationColor))
+		fmt.Printf(format, args...)
+		fmt.Print(paint(outputColor))
+	}
+}
+
+// details are only printed with -v
+func detail(format string, args ...interface{}) {
+	if level >= verbose {
+		fmt.Print(paint(detailColor))
+		fmt.Printf(format, args...)
+		fmt.Print(paint(resetColor), paint(outputColor))
+	}
+}
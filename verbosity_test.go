@@ -0,0 +1,34 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestVerbosityLevels(t *testing.T) {
+	levelReal := level
+	defer func() { level = levelReal }()
+
+	var tests = []struct {
+		level verbosity
+		want  string
+	}{
+		{quiet, "result\n"},
+		{normal, "narration\nresult\n"},
+		{verbose, "narration\ndetail\nresult\n"},
+	}
+	for _, test := range tests {
+		level = test.level
+		output, err := captureOutput(func() {
+			narrate("narration\n")
+			detail("detail\n")
+			fmt.Println("result")
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if output != test.want {
+			t.Errorf("level %v printed %q, want %q", test.level, output, test.want)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+)
+
+// a fixed number of workers share one jobs channel
+// each job is received by exactly one of them
+// closing the jobs channel shuts the pool down gracefully
+// the workers finish the jobs already sent and return
+// and the results channel closes after the last one
+func WorkerPool[J, R any](workers int, jobs <-chan J, work func(J) R) <-chan R {
+	results := make(chan R)
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := range jobs {
+				results <- work(job)
+			}
+		}()
+	}
+
+	// closing results from a worker
+	// would panic the others still sending
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+	return results
+}
+
+func workerPoolsLesson() {
+
+	// squaring numbers with three workers
+	// the results arrive in any order
+	trace()
+	jobs := make(chan int)
+	go func() {
+		for i := 1; i <= 6; i++ {
+			jobs <- i
+		}
+		close(jobs)
+	}()
+
+	var squares []int
+	for square := range WorkerPool(3, jobs, func(n int) int { return n * n }) {
+		squares = append(squares, square)
+	}
+	slices.Sort(squares)
+	fmt.Printf("squares: %v\n", squares)
+	// Output:
+	// squares: [1 4 9 16 25 36]
+
+	// the pool bounds how many jobs run at once
+	// however many are queued
+	trace()
+	var mutex sync.Mutex
+	running, busiest := 0, 0
+	slowJobs := make(chan int, 10)
+	for i := range 10 {
+		slowJobs <- i
+	}
+	close(slowJobs)
+
+	slowWork := func(int) bool {
+		mutex.Lock()
+		running++
+		busiest = max(busiest, running)
+		mutex.Unlock()
+
+		time.Sleep(5 * time.Millisecond)
+
+		mutex.Lock()
+		running--
+		mutex.Unlock()
+		return true
+	}
+
+	done := 0
+	for range WorkerPool(2, slowJobs, slowWork) {
+		done++
+	}
+	fmt.Printf("done: %v, never more than 2 at once: %v\n", done, busiest <= 2)
+	// Output:
+	// done: 10, never more than 2 at once: true
+}
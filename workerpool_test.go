@@ -0,0 +1,52 @@
+package main
+
+import (
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestWorkerPoolProcessesEveryJob(t *testing.T) {
+	jobs := make(chan int, 5)
+	for i := range 5 {
+		jobs <- i
+	}
+	close(jobs)
+
+	var doubled []int
+	for result := range WorkerPool(3, jobs, func(n int) int { return n * 2 }) {
+		doubled = append(doubled, result)
+	}
+	slices.Sort(doubled)
+	if want := []int{0, 2, 4, 6, 8}; !slices.Equal(doubled, want) {
+		t.Errorf("WorkerPool results = %v, want %v", doubled, want)
+	}
+}
+
+func TestWorkerPoolBoundsConcurrency(t *testing.T) {
+	jobs := make(chan int, 8)
+	for i := range 8 {
+		jobs <- i
+	}
+	close(jobs)
+
+	var mutex sync.Mutex
+	running, busiest := 0, 0
+	work := func(int) int {
+		mutex.Lock()
+		running++
+		busiest = max(busiest, running)
+		mutex.Unlock()
+		time.Sleep(5 * time.Millisecond)
+		mutex.Lock()
+		running--
+		mutex.Unlock()
+		return 0
+	}
+	for range WorkerPool(2, jobs, work) {
+	}
+	if busiest > 2 {
+		t.Errorf("WorkerPool(2) ran %v jobs at once", busiest)
+	}
+}